@@ -0,0 +1,11 @@
+data "runscope_environment" "shared" {
+  bucket_id = runscope_bucket.my_bucket.id
+  name      = "production"
+}
+
+resource "runscope_schedule" "smoke_test" {
+  bucket_id      = runscope_bucket.my_bucket.id
+  test_id        = runscope_test.smoke_test.id
+  environment_id = data.runscope_environment.shared.id
+  interval       = "5m"
+}
@@ -0,0 +1,15 @@
+# Shared environments of the bucket
+data "runscope_environments" "shared" {
+  bucket_id = runscope_bucket.my_bucket.id
+
+  filter {
+    name   = "name"
+    values = ["production", "staging"]
+  }
+}
+
+# Environments of a test
+data "runscope_environments" "smoke_test" {
+  bucket_id = runscope_bucket.my_bucket.id
+  test_id   = runscope_test.smoke_test.id
+}
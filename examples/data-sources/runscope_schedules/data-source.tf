@@ -0,0 +1,14 @@
+data "runscope_schedules" "smoke_test" {
+  bucket_id = runscope_bucket.my_bucket.id
+  test_id   = runscope_test.smoke_test.id
+}
+
+# Schedules not managed by Terraform, ready to be imported as
+# bucket_id/test_id/schedule_id.
+output "unmanaged_schedules" {
+  value = [
+    for id in data.runscope_schedules.smoke_test.ids :
+    "${runscope_bucket.my_bucket.id}/${runscope_test.smoke_test.id}/${id}"
+    if !contains([runscope_schedule.hourly.id], id)
+  ]
+}
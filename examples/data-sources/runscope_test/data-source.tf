@@ -0,0 +1,11 @@
+data "runscope_test" "smoke_test" {
+  bucket_id = runscope_bucket.my_bucket.id
+  name      = "smoke test"
+}
+
+resource "runscope_schedule" "smoke_test" {
+  bucket_id      = runscope_bucket.my_bucket.id
+  test_id        = data.runscope_test.smoke_test.id
+  environment_id = data.runscope_test.smoke_test.default_environment_id
+  interval       = "5m"
+}
@@ -0,0 +1,18 @@
+data "runscope_test_results" "smoke_test" {
+  bucket_id      = runscope_bucket.my_bucket.id
+  test_id        = runscope_test.smoke_test.id
+  environment_id = runscope_environment.production.id
+  limit          = 5
+}
+
+output "smoke_test_status" {
+  value = data.runscope_test_results.smoke_test.latest_result
+}
+
+output "smoke_test_failed_assertions" {
+  value = flatten([
+    for step in data.runscope_test_results.smoke_test.results[0].steps : [
+      for assertion in step.assertions : assertion if assertion.result == "fail"
+    ]
+  ])
+}
@@ -0,0 +1,13 @@
+data "runscope_tests" "smoke_tests" {
+  bucket_id = runscope_bucket.my_bucket.id
+
+  filter {
+    name   = "name"
+    values = ["^smoke"]
+  }
+
+  filter {
+    name   = "created_by"
+    values = ["grace@example.com"]
+  }
+}
@@ -0,0 +1,5 @@
+# Shared environments are imported using bucket_id/environment_id
+terraform import runscope_environment.my_environment bucket_id/environment_id
+
+# Test environments are imported using bucket_id/test_id/environment_id
+terraform import runscope_environment.my_test_environment bucket_id/test_id/environment_id
@@ -0,0 +1,11 @@
+# Suspend the schedules of every test of the bucket while the API is
+# migrated. Applies made after 22:00 and after 02:00 suspend and restore
+# them, e.g. from a scheduled CI job.
+resource "runscope_maintenance_window" "migration" {
+  bucket_id = runscope_bucket.my_bucket.id
+  starts_at = "2024-06-01T22:00:00Z"
+  ends_at   = "2024-06-02T02:00:00Z"
+
+  # Schedules managed by Terraform keep running.
+  exclude_schedule_ids = [runscope_schedule.hourly.id]
+}
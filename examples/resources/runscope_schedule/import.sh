@@ -0,0 +1,2 @@
+# Schedules are imported using bucket_id/test_id/schedule_id
+terraform import runscope_schedule.my_schedule bucket_id/test_id/schedule_id
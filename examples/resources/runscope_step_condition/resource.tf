@@ -0,0 +1,16 @@
+resource "runscope_step_condition" "feature_enabled" {
+  bucket_id   = runscope_bucket.my_bucket.id
+  test_id     = runscope_test.my_test.id
+  left_value  = "{{feature_enabled}}"
+  comparison  = "equal"
+  right_value = "true"
+}
+
+resource "runscope_step_request" "feature_endpoint" {
+  bucket_id = runscope_bucket.my_bucket.id
+  test_id   = runscope_test.my_test.id
+  url       = "https://example.com/feature"
+  method    = "GET"
+
+  depends_on = [runscope_step_condition.feature_enabled]
+}
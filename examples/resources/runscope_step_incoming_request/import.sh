@@ -0,0 +1,5 @@
+# Incoming request steps are imported using bucket_id/test_id/step_id
+terraform import runscope_step_incoming_request.my_step bucket_id/test_id/step_id
+
+# or by their position in the test using bucket_id/test_id#step_position
+terraform import runscope_step_incoming_request.my_step bucket_id/test_id#2
@@ -0,0 +1,30 @@
+resource "runscope_step_incoming_request" "webhook" {
+  bucket_id = runscope_bucket.my_bucket.id
+  test_id   = runscope_test.my_test.id
+  note      = "Wait for the subscription webhook"
+
+  assertion {
+    source     = "response_json"
+    property   = "type"
+    comparison = "equal"
+    value      = "subscription.created"
+  }
+
+  variable {
+    name     = "subscription_id"
+    property = "data.id"
+    source   = "response_json"
+  }
+}
+
+# Pass the capture URL to the system under test, e.g. through an environment
+# used by the step subscribing to the webhook.
+resource "runscope_environment" "webhook" {
+  bucket_id = runscope_bucket.my_bucket.id
+  test_id   = runscope_test.my_test.id
+  name      = "webhook"
+
+  initial_variables = {
+    callback_url = runscope_step_incoming_request.webhook.capture_url
+  }
+}
@@ -0,0 +1,14 @@
+resource "runscope_step_request" "create_item" {
+  bucket_id = runscope_bucket.my_bucket.id
+  test_id   = runscope_test.my_test.id
+  url       = "https://example.com/items"
+  method    = "POST"
+}
+
+resource "runscope_step_pause" "wait_for_consistency" {
+  bucket_id = runscope_bucket.my_bucket.id
+  test_id   = runscope_test.my_test.id
+  duration  = 5
+
+  depends_on = [runscope_step_request.create_item]
+}
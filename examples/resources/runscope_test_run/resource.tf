@@ -0,0 +1,18 @@
+resource "runscope_test_run" "smoke_test" {
+  bucket_id      = runscope_bucket.my_bucket.id
+  test_id        = runscope_test.smoke_test.id
+  environment_id = runscope_environment.production.id
+
+  initial_variables = {
+    base_url = "https://api.example.com"
+  }
+
+  # Run the smoke test again whenever a new version is deployed.
+  triggers = {
+    version = var.app_version
+  }
+
+  timeouts {
+    create = "15m"
+  }
+}
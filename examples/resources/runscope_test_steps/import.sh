@@ -0,0 +1,2 @@
+# The order of the steps of a test is imported using bucket_id/test_id
+terraform import runscope_test_steps.my_test bucket_id/test_id
@@ -0,0 +1,10 @@
+resource "runscope_test_steps" "my_test" {
+  bucket_id = runscope_bucket.my_bucket.id
+  test_id   = runscope_test.my_test.id
+
+  step_ids = [
+    runscope_step_request.login.id,
+    runscope_step_request.create_order.id,
+    runscope_step_request.logout.id,
+  ]
+}
@@ -0,0 +1,249 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope"
+)
+
+func dataSourceRunscopeEnvironment() *schema.Resource {
+	s := environmentDataSourceSchema()
+	s["bucket_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	}
+	s["test_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The test to look up the environment in. Shared environments of the bucket are looked up when not set.",
+	}
+	s["id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"id", "name"},
+	}
+	s["name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		Description: "The name of the environment, which must be unique among the environments looked up.",
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceRunscopeEnvironmentRead,
+		Schema:      s,
+	}
+}
+
+// environmentDataSourceSchema returns the computed attributes describing an
+// environment, shared by the environment data sources.
+func environmentDataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"script": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"header": {
+			Type:     schema.TypeSet,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"header": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"value": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+		"preserve_cookies": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"initial_variables": {
+			Type:     schema.TypeMap,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"integrations": {
+			Type:     schema.TypeSet,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"regions": {
+			Type:     schema.TypeSet,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"remote_agent": {
+			Type:     schema.TypeSet,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"uuid": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+		"retry_on_failure": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"stop_on_failure": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"verify_ssl": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"webhooks": {
+			Type:     schema.TypeSet,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"email": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"notify_all": {
+						Type:     schema.TypeBool,
+						Computed: true,
+					},
+					"notify_on": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"notify_threshold": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"recipient": {
+						Type:     schema.TypeSet,
+						Computed: true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"id": {
+									Type:     schema.TypeString,
+									Computed: true,
+								},
+								"name": {
+									Type:     schema.TypeString,
+									Computed: true,
+								},
+								"email": {
+									Type:     schema.TypeString,
+									Computed: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"parent_environment_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"client_certificate": {
+			Type:      schema.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+	}
+}
+
+func dataSourceRunscopeEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerConfig).client
+
+	uriOpts := runscope.EnvironmentUriOpts{
+		BucketId: d.Get("bucket_id").(string),
+		TestId:   d.Get("test_id").(string),
+	}
+
+	var env *runscope.Environment
+	if id, ok := d.GetOk("id"); ok {
+		opts := &runscope.EnvironmentGetOpts{EnvironmentUriOpts: uriOpts, Id: id.(string)}
+		e, err := client.Environment.Get(ctx, opts)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		env = e
+	} else {
+		name := d.Get("name").(string)
+		envs, err := client.Environment.List(ctx, &runscope.EnvironmentListOpts{EnvironmentUriOpts: uriOpts})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		for _, e := range envs {
+			if e.Name != name {
+				continue
+			}
+			if env != nil {
+				return diag.Errorf("Found more than one environment named %q", name)
+			}
+			env = e
+		}
+
+		if env == nil {
+			return diag.Errorf("Couldn't find an environment named %q", name)
+		}
+	}
+
+	d.SetId(env.Id)
+	for k, v := range flattenEnvironment(env) {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func flattenEnvironment(env *runscope.Environment) map[string]interface{} {
+	var emails []interface{}
+	if !env.Emails.IsDefault() {
+		emails = flattenEmails(env.Emails)
+	}
+
+	return map[string]interface{}{
+		"id":                    env.Id,
+		"name":                  env.Name,
+		"script":                env.Script,
+		"header":                flattenStepHeaders(env.Headers),
+		"preserve_cookies":      env.PreserveCookies,
+		"initial_variables":     env.InitialVariables,
+		"integrations":          env.Integrations,
+		"regions":               env.Regions,
+		"remote_agent":          flattenEnvironmentRemoteAgents(env.RemoteAgents),
+		"retry_on_failure":      env.RetryOnFailure,
+		"stop_on_failure":       env.StopOnFailure,
+		"verify_ssl":            env.VerifySSL,
+		"webhooks":              env.Webhooks,
+		"email":                 emails,
+		"parent_environment_id": env.ParentEnvironmentId,
+		"client_certificate":    env.ClientCertificate,
+	}
+}
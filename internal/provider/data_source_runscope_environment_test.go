@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceRunscopeEnvironment(t *testing.T) {
+	teamId := os.Getenv("RUNSCOPE_TEAM_ID")
+	bucketName := testAccRandomBucketName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDataSourceRunscopeEnvironmentConfig, teamId, bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.runscope_environment.by_name", "id", "runscope_environment.shared", "id"),
+					resource.TestCheckResourceAttr("data.runscope_environment.by_name", "script", "var a = 1;"),
+					resource.TestCheckResourceAttr("data.runscope_environment.by_name", "initial_variables.base_url", "https://example.com"),
+					resource.TestCheckResourceAttr("data.runscope_environment.by_name", "header.#", "1"),
+					resource.TestCheckResourceAttr("data.runscope_environment.by_name", "regions.#", "2"),
+					resource.TestCheckResourceAttr("data.runscope_environment.by_name", "verify_ssl", "false"),
+					resource.TestCheckResourceAttr("data.runscope_environment.by_name", "email.0.notify_on", "failures"),
+					resource.TestCheckResourceAttrPair("data.runscope_environment.by_id", "name", "runscope_environment.shared", "name"),
+					resource.TestCheckResourceAttr("data.runscope_environments.test", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.runscope_environments.test", "environments.0.name", "Test Settings"),
+					resource.TestCheckResourceAttrPair("data.runscope_environments.test", "environments.0.id", "runscope_test.test", "default_environment_id"),
+					resource.TestCheckResourceAttr("data.runscope_environments.shared", "environments.#", "1"),
+					resource.TestCheckResourceAttr("data.runscope_environments.shared", "environments.0.initial_variables.base_url", "https://example.com"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceRunscopeEnvironmentConfig = `
+resource "runscope_bucket" "test" {
+  team_uuid = "%s"
+  name      = "%s"
+}
+
+resource "runscope_test" "test" {
+  bucket_id = runscope_bucket.test.id
+  name      = "environment test"
+}
+
+resource "runscope_environment" "shared" {
+  bucket_id  = runscope_bucket.test.id
+  name       = "shared-environment"
+  script     = "var a = 1;"
+  regions    = ["us1", "eu1"]
+  verify_ssl = false
+
+  initial_variables = {
+    base_url = "https://example.com"
+  }
+
+  header {
+    header = "Authorization"
+    value  = "Bearer {{token}}"
+  }
+
+  email {
+    notify_all = false
+    notify_on  = "failures"
+  }
+}
+
+data "runscope_environment" "by_name" {
+  bucket_id = runscope_bucket.test.id
+  name      = runscope_environment.shared.name
+}
+
+data "runscope_environment" "by_id" {
+  bucket_id = runscope_bucket.test.id
+  id        = runscope_environment.shared.id
+}
+
+data "runscope_environments" "shared" {
+  bucket_id = runscope_bucket.test.id
+
+  filter {
+    name   = "name"
+    values = [runscope_environment.shared.name]
+  }
+}
+
+data "runscope_environments" "test" {
+  bucket_id = runscope_bucket.test.id
+  test_id   = runscope_test.test.id
+}
+`
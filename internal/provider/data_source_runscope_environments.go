@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope"
+)
+
+func dataSourceRunscopeEnvironments() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRunscopeEnvironmentsRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"test_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The test to list environments of. Shared environments of the bucket are listed when not set.",
+			},
+			"filter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"id", "name"}, false),
+						},
+						"values": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"environments": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: environmentDataSourceSchema(),
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRunscopeEnvironmentsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerConfig).client
+
+	filters, filtersOk := d.GetOk("filter")
+
+	opts := &runscope.EnvironmentListOpts{}
+	opts.BucketId = d.Get("bucket_id").(string)
+	opts.TestId = d.Get("test_id").(string)
+
+	envs, err := client.Environment.List(ctx, opts)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var ids []string
+	var environments []interface{}
+	for _, env := range envs {
+		if filtersOk && !environmentFiltersTest(env, filters.(*schema.Set)) {
+			continue
+		}
+
+		ids = append(ids, env.Id)
+		environments = append(environments, flattenEnvironment(env))
+	}
+
+	d.SetId(time.Now().UTC().String())
+	d.Set("ids", ids)
+	if err := d.Set("environments", environments); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func environmentFiltersTest(env *runscope.Environment, filters *schema.Set) bool {
+	for _, v := range filters.List() {
+		m := v.(map[string]interface{})
+		passed := false
+
+		for _, e := range m["values"].(*schema.Set).List() {
+			switch m["name"].(string) {
+			case "id":
+				if env.Id == e {
+					passed = true
+				}
+			default:
+				if env.Name == e {
+					passed = true
+				}
+			}
+		}
+
+		if !passed {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestDataSourceRunscopeEnvironments_filterName(t *testing.T) {
+	tests := []struct {
+		name  string
+		valid bool
+	}{
+		{"id", true},
+		{"name", true},
+		{"created_by", false},
+		{"Name", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"bucket_id": "bucket",
+				"filter": []interface{}{map[string]interface{}{
+					"name":   tt.name,
+					"values": []interface{}{"staging"},
+				}},
+			})
+
+			diags := dataSourceRunscopeEnvironments().Validate(config)
+			if tt.valid && diags.HasError() {
+				t.Errorf("expected filter %s to be valid, got %v", tt.name, diags)
+			}
+			if !tt.valid && !diags.HasError() {
+				t.Errorf("expected filter %s to be rejected", tt.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope"
+)
+
+func dataSourceRunscopeSchedules() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRunscopeSchedulesRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"test_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"filter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"values": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"schedules": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"environment_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"interval": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"note": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRunscopeSchedulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerConfig).client
+
+	filters, filtersOk := d.GetOk("filter")
+
+	opts := &runscope.ScheduleListOpts{}
+	opts.BucketId = d.Get("bucket_id").(string)
+	opts.TestId = d.Get("test_id").(string)
+
+	schedules, err := client.Schedule.List(ctx, opts)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var ids []string
+	var list []interface{}
+	for _, schedule := range schedules {
+		// Report intervals the way runscope_schedule accepts them.
+		schedule.Interval = flattenScheduleInterval(schedule.Interval)
+
+		if filtersOk && !scheduleFiltersTest(schedule, filters.(*schema.Set)) {
+			continue
+		}
+
+		ids = append(ids, schedule.Id)
+		list = append(list, map[string]interface{}{
+			"id":             schedule.Id,
+			"environment_id": schedule.EnvironmentId,
+			"interval":       schedule.Interval,
+			"note":           schedule.Note,
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", opts.BucketId, opts.TestId))
+	d.Set("ids", ids)
+	if err := d.Set("schedules", list); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func scheduleFiltersTest(schedule *runscope.Schedule, filters *schema.Set) bool {
+	for _, v := range filters.List() {
+		m := v.(map[string]interface{})
+		passed := false
+
+		for _, e := range m["values"].(*schema.Set).List() {
+			switch m["name"].(string) {
+			case "environment_id":
+				if schedule.EnvironmentId == e {
+					passed = true
+				}
+			case "interval":
+				if schedule.Interval == e {
+					passed = true
+				}
+			case "note":
+				if schedule.Note == e {
+					passed = true
+				}
+			default:
+				if schedule.Id == e {
+					passed = true
+				}
+			}
+		}
+
+		if !passed {
+			return false
+		}
+	}
+	return true
+}
+
+// flattenScheduleInterval returns an interval as read from the API, e.g.
+// "1.0h", in the form it is set in, e.g. "1h". Only a fraction of zeros is
+// dropped, so that any other interval shows up as it is.
+func flattenScheduleInterval(interval string) string {
+	unit := strings.TrimLeft(interval, "0123456789.")
+	number := strings.TrimSuffix(interval, unit)
+	if i := strings.Index(number, "."); i > 0 && strings.Trim(number[i+1:], "0") == "" {
+		number = number[:i]
+	}
+	return number + unit
+}
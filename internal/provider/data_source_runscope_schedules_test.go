@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceRunscopeSchedules(t *testing.T) {
+	teamId := os.Getenv("RUNSCOPE_TEAM_ID")
+	bucketName := testAccRandomBucketName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDataSourceRunscopeSchedulesConfig, teamId, bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.runscope_schedules.all", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.runscope_schedules.hourly", "ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("data.runscope_schedules.hourly", "ids.*", "runscope_schedule.hourly", "id"),
+					resource.TestCheckResourceAttr("data.runscope_schedules.hourly", "schedules.0.interval", "1h"),
+					resource.TestCheckResourceAttr("data.runscope_schedules.hourly", "schedules.0.note", "hourly"),
+					resource.TestCheckResourceAttrPair("data.runscope_schedules.hourly", "schedules.0.environment_id", "runscope_test.test", "default_environment_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenScheduleInterval(t *testing.T) {
+	for interval, expected := range map[string]string{
+		"1.0m":  "1m",
+		"15.0m": "15m",
+		"10.0h": "10h",
+		"1.0d":  "1d",
+		"6h":    "6h",
+		"1.5h":  "1.5h",
+		"1.05h": "1.05h",
+		"2.00d": "2d",
+		"":      "",
+	} {
+		if actual := flattenScheduleInterval(interval); actual != expected {
+			t.Errorf("flattenScheduleInterval(%q) = %q, expected %q", interval, actual, expected)
+		}
+	}
+}
+
+const testAccDataSourceRunscopeSchedulesConfig = `
+resource "runscope_bucket" "test" {
+  team_uuid = "%s"
+  name      = "%s"
+}
+
+resource "runscope_test" "test" {
+  bucket_id = runscope_bucket.test.id
+  name      = "schedules test"
+}
+
+resource "runscope_schedule" "hourly" {
+  bucket_id      = runscope_bucket.test.id
+  test_id        = runscope_test.test.id
+  environment_id = runscope_test.test.default_environment_id
+  interval       = "1h"
+  note           = "hourly"
+}
+
+resource "runscope_schedule" "daily" {
+  bucket_id      = runscope_bucket.test.id
+  test_id        = runscope_test.test.id
+  environment_id = runscope_test.test.default_environment_id
+  interval       = "1d"
+  note           = "daily"
+}
+
+data "runscope_schedules" "all" {
+  bucket_id = runscope_bucket.test.id
+  test_id   = runscope_test.test.id
+
+  depends_on = [runscope_schedule.hourly, runscope_schedule.daily]
+}
+
+data "runscope_schedules" "hourly" {
+  bucket_id = runscope_bucket.test.id
+  test_id   = runscope_test.test.id
+
+  filter {
+    name   = "interval"
+    values = ["1h"]
+  }
+
+  depends_on = [runscope_schedule.hourly, runscope_schedule.daily]
+}
+`
@@ -24,10 +24,7 @@ func dataSourceRunscopeTeam() *schema.Resource {
 }
 
 func dataSourceRunscopeTeamRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*providerConfig).client
-
-	opts := &runscope.AccountGetOpts{}
-	account, err := client.Account.Get(ctx, opts)
+	account, err := meta.(*providerConfig).getAccount(ctx)
 	if err != nil {
 		return diag.FromErr(err)
 	}
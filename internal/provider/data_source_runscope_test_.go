@@ -0,0 +1,142 @@
+// Note this source file ends in an '_'; otherwise the compiler
+// will treat is as a test file.
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope"
+)
+
+func dataSourceRunscopeTest() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRunscopeTestRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the test, which must be unique within the bucket.",
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_environment_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"trigger_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_by": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"email": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"steps": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"step_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRunscopeTestRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerConfig).client
+
+	bucketId := d.Get("bucket_id").(string)
+	id := d.Get("id").(string)
+
+	if name, ok := d.GetOk("name"); ok && id == "" {
+		tests, err := client.Test.List(ctx, runscope.TestListOpts{BucketId: bucketId})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		for _, test := range tests {
+			if test.Name != name {
+				continue
+			}
+			if id != "" {
+				return diag.Errorf("Found more than one test named %q in bucket %s", name, bucketId)
+			}
+			id = test.Id
+		}
+
+		if id == "" {
+			return diag.Errorf("Couldn't find a test named %q in bucket %s", name, bucketId)
+		}
+	}
+
+	// The list leaves out the steps, so read the test itself.
+	test, err := client.Test.Get(ctx, runscope.TestGetOpts{BucketId: bucketId, Id: id})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	steps := make([]interface{}, len(test.Steps))
+	for i, step := range test.Steps {
+		steps[i] = map[string]interface{}{
+			"id":        step.Id,
+			"step_type": step.StepType,
+		}
+	}
+
+	d.SetId(test.Id)
+	d.Set("name", test.Name)
+	d.Set("description", test.Description)
+	d.Set("default_environment_id", test.DefaultEnvironmentId)
+	d.Set("trigger_url", test.TriggerURL)
+	d.Set("created_at", flattenTime(test.CreatedAt))
+	d.Set("created_by", flattenCreatedBy(&test.CreatedBy))
+	d.Set("steps", steps)
+
+	return nil
+}
@@ -0,0 +1,326 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope"
+)
+
+// maxResultCount is the most results the API returns in one list.
+const maxResultCount = 50
+
+func dataSourceRunscopeTestResults() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRunscopeTestResultsRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The bucket of the test.",
+			},
+			"test_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the test to read results of.",
+			},
+			"environment_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return results of runs in this environment. Results are listed 50 at a time until enough runs in the environment are found, which takes more requests when other environments run more often.",
+			},
+			"limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntBetween(1, maxResultCount),
+				Description:  "The number of results to return, most recent first.",
+			},
+			"latest_result": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The result of the most recent run, e.g. `pass`, `fail` or `working`.",
+			},
+			"results": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The results, most recent first.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"test_run_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"test_run_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"environment_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"environment_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"result": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"started_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"finished_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"assertions_defined": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"assertions_passed": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"assertions_failed": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"requests_executed": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"steps": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     dataSourceRunscopeTestResultStep(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRunscopeTestResultStep() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"step_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"step_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"method": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"result": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"response_status_code": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"response_time_ms": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"assertions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"property": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"comparison": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"target_value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"actual_value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"result": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"error": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"variables": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"property": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"result": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"error": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"scripts": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"output": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"result": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"error": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRunscopeTestResultsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerConfig).client
+
+	bucketId := d.Get("bucket_id").(string)
+	testId := d.Get("test_id").(string)
+	environmentId := d.Get("environment_id").(string)
+	limit := d.Get("limit").(int)
+
+	summaries, err := listTestResults(ctx, client, bucketId, testId, environmentId, limit)
+	if err != nil {
+		return diag.Errorf("Couldn't list results of test %s: %s", testId, err)
+	}
+
+	// Listed results leave out the steps, so every result is read on its
+	// own to get the outcome of each step.
+	results := make([]*runscope.Result, len(summaries))
+	for i, summary := range summaries {
+		getOpts := &runscope.ResultGetOpts{Id: summary.TestRunId}
+		getOpts.BucketId = bucketId
+		getOpts.TestId = testId
+		result, err := client.Result.Get(ctx, getOpts)
+		if err != nil {
+			return diag.Errorf("Couldn't read result %s: %s", summary.TestRunId, err)
+		}
+		results[i] = result
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", bucketId, testId))
+	d.Set("latest_result", "")
+	if len(results) > 0 {
+		d.Set("latest_result", results[0].Result)
+	}
+	if err := d.Set("results", flattenResults(results)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// listTestResults lists the limit most recent results of a test, only
+// counting runs in the given environment when one is set. Results of other
+// environments are filtered out of the list, paging through older results
+// until limit results are found or there are none left.
+func listTestResults(ctx context.Context, client *runscope.Client, bucketId, testId, environmentId string, limit int) ([]*runscope.Result, error) {
+	opts := &runscope.ResultListOpts{Count: limit}
+	opts.BucketId = bucketId
+	opts.TestId = testId
+	if environmentId != "" {
+		opts.Count = maxResultCount
+	}
+
+	var results []*runscope.Result
+	seen := map[string]bool{}
+	for {
+		page, err := client.Result.List(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		found := false
+		for _, result := range page {
+			if seen[result.TestRunId] {
+				continue
+			}
+			seen[result.TestRunId] = true
+			found = true
+
+			if environmentId != "" && result.EnvironmentId != environmentId {
+				continue
+			}
+			results = append(results, result)
+			if len(results) == limit {
+				return results, nil
+			}
+		}
+
+		if !found || environmentId == "" || len(page) < opts.Count {
+			return results, nil
+		}
+
+		// Start times are reported in milliseconds, so runs started in the
+		// same millisecond as the last one are listed again and skipped.
+		last := page[len(page)-1].StartedAt
+		if last.IsZero() {
+			return results, nil
+		}
+		opts.Before = last.Add(time.Millisecond)
+	}
+}
@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope"
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope/runscopetest"
+)
+
+func TestAccDataSourceRunscopeTestResults(t *testing.T) {
+	teamId := os.Getenv("RUNSCOPE_TEAM_ID")
+	bucketName := testAccRandomBucketName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDataSourceRunscopeTestResultsConfig, teamId, bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.runscope_test_results.test", "latest_result", "pass"),
+					resource.TestCheckResourceAttr("data.runscope_test_results.test", "results.#", "1"),
+					resource.TestCheckResourceAttrPair("data.runscope_test_results.test", "results.0.test_run_id", "runscope_test_run.test", "id"),
+					resource.TestCheckResourceAttr("data.runscope_test_results.test", "results.0.steps.#", "1"),
+					resource.TestCheckResourceAttrPair("data.runscope_test_results.test", "results.0.steps.0.step_id", "runscope_step_request.test", "id"),
+					resource.TestCheckResourceAttr("data.runscope_test_results.test", "results.0.steps.0.assertions.#", "1"),
+					resource.TestCheckResourceAttr("data.runscope_test_results.test", "results.0.steps.0.assertions.0.source", "response_status"),
+					resource.TestCheckResourceAttr("data.runscope_test_results.test", "results.0.steps.0.assertions.0.result", "pass"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceRunscopeTestResults_environment(t *testing.T) {
+	ctx := context.Background()
+	server := runscopetest.NewServer()
+	defer server.Close()
+	meta := testProviderConfig(server)
+
+	bucket, err := meta.client.Bucket.Create(ctx, &runscope.BucketCreateOpts{Name: "bucket", TeamUUID: server.TeamID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	test, err := meta.client.Test.Create(ctx, runscope.TestCreateOpts{BucketId: bucket.Key})
+	if err != nil {
+		t.Fatal(err)
+	}
+	envOpts := &runscope.EnvironmentCreateOpts{}
+	envOpts.BucketId = bucket.Key
+	envOpts.Name = "staging"
+	env, err := meta.client.Environment.Create(ctx, envOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The staging runs are older than a full page of runs in the default
+	// environment.
+	trigger := func(environmentId string) {
+		if _, err := meta.client.Test.Trigger(ctx, runscope.TestTriggerOpts{TriggerURL: test.TriggerURL, EnvironmentId: environmentId}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := meta.client.Result.Latest(ctx, &runscope.ResultURLOpts{BucketId: bucket.Key, TestId: test.Id}); err != nil {
+			t.Fatal(err)
+		}
+		time.Sleep(2 * time.Millisecond)
+	}
+	for i := 0; i < 2; i++ {
+		trigger(env.Id)
+	}
+	for i := 0; i < maxResultCount+5; i++ {
+		trigger("")
+	}
+
+	for _, limit := range []int{1, 10} {
+		d := schema.TestResourceDataRaw(t, dataSourceRunscopeTestResults().Schema, map[string]interface{}{
+			"bucket_id":      bucket.Key,
+			"test_id":        test.Id,
+			"environment_id": env.Id,
+			"limit":          limit,
+		})
+		if diags := dataSourceRunscopeTestResultsRead(ctx, d, meta); diags.HasError() {
+			t.Fatal(diags[0].Summary)
+		}
+
+		expected := 2
+		if limit < expected {
+			expected = limit
+		}
+		results := d.Get("results").([]interface{})
+		if len(results) != expected {
+			t.Fatalf("expected %d results with limit %d, got %d", expected, limit, len(results))
+		}
+		for _, r := range results {
+			if id := r.(map[string]interface{})["environment_id"]; id != env.Id {
+				t.Errorf("expected results of environment %s, got %s", env.Id, id)
+			}
+		}
+	}
+}
+
+const testAccDataSourceRunscopeTestResultsConfig = `
+resource "runscope_bucket" "test" {
+  team_uuid = "%s"
+  name      = "%s"
+}
+
+resource "runscope_test" "test" {
+  bucket_id = runscope_bucket.test.id
+  name      = "results test"
+}
+
+resource "runscope_step_request" "test" {
+  bucket_id = runscope_bucket.test.id
+  test_id   = runscope_test.test.id
+  method    = "GET"
+  url       = "https://example.com"
+
+  assertion {
+    source     = "response_status"
+    comparison = "equal_number"
+    value      = "200"
+  }
+}
+
+resource "runscope_test_run" "test" {
+  bucket_id = runscope_bucket.test.id
+  test_id   = runscope_test.test.id
+
+  depends_on = [runscope_step_request.test]
+}
+
+data "runscope_test_results" "test" {
+  bucket_id = runscope_bucket.test.id
+  test_id   = runscope_test.test.id
+  limit     = 1
+
+  depends_on = [runscope_test_run.test]
+}
+`
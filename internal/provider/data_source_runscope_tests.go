@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope"
+)
+
+func dataSourceRunscopeTests() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRunscopeTestsRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"filter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"id", "name", "created_by"}, false),
+							Description:  "The attribute to filter on. `name` values are regular expressions, `created_by` values match the ID, name or email of the creator.",
+						},
+						"values": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceRunscopeTestsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerConfig).client
+
+	filters, filtersOk := d.GetOk("filter")
+
+	tests, err := client.Test.List(ctx, runscope.TestListOpts{BucketId: d.Get("bucket_id").(string)})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var ids []string
+	for _, test := range tests {
+		if filtersOk {
+			passed, err := testFiltersTest(test, filters.(*schema.Set))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			if !passed {
+				continue
+			}
+		}
+
+		ids = append(ids, test.Id)
+	}
+
+	d.SetId(time.Now().UTC().String())
+	d.Set("ids", ids)
+
+	return nil
+}
+
+func testFiltersTest(test *runscope.Test, filters *schema.Set) (bool, error) {
+	for _, v := range filters.List() {
+		m := v.(map[string]interface{})
+		passed := false
+
+		for _, e := range m["values"].(*schema.Set).List() {
+			switch m["name"].(string) {
+			case "id":
+				if test.Id == e {
+					passed = true
+				}
+			case "created_by":
+				if test.CreatedBy.Id == e || test.CreatedBy.Name == e || test.CreatedBy.Email == e {
+					passed = true
+				}
+			default:
+				re, err := regexp.Compile(e.(string))
+				if err != nil {
+					return false, err
+				}
+				if re.MatchString(test.Name) {
+					passed = true
+				}
+			}
+		}
+
+		if !passed {
+			return false, nil
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope"
+)
+
+func TestAccDataSourceRunscopeTests(t *testing.T) {
+	teamId := os.Getenv("RUNSCOPE_TEAM_ID")
+	bucketName := testAccRandomBucketName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDataSourceRunscopeTestsConfig, teamId, bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.runscope_tests.all", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.runscope_tests.api", "ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("data.runscope_tests.api", "ids.*", "runscope_test.api", "id"),
+					resource.TestCheckResourceAttrPair("data.runscope_test.api", "id", "runscope_test.api", "id"),
+					resource.TestCheckResourceAttrPair("data.runscope_test.api", "default_environment_id", "runscope_test.api", "default_environment_id"),
+					resource.TestCheckResourceAttrPair("data.runscope_test.api", "trigger_url", "runscope_test.api", "trigger_url"),
+					resource.TestCheckResourceAttr("data.runscope_test.api", "steps.#", "1"),
+					resource.TestCheckResourceAttrPair("data.runscope_test.api", "steps.0.id", "runscope_step_request.api", "id"),
+					resource.TestCheckResourceAttr("data.runscope_test.api", "steps.0.step_type", "request"),
+				),
+			},
+			{
+				Config:      fmt.Sprintf(testAccDataSourceRunscopeTestMissingConfig, teamId, bucketName),
+				ExpectError: regexp.MustCompile("Couldn't find a test named"),
+			},
+		},
+	})
+}
+
+func TestTestFiltersTest(t *testing.T) {
+	test := &runscope.Test{
+		TestMinimal: runscope.TestMinimal{Name: "api smoke test"},
+		Id:          "0aa48464-f89e-4596-8d60-79bc678d36f4",
+		CreatedBy: runscope.CreatedBy{
+			Id:    "c8ffd67b-c281-45d3-9735-3f40ee567a02",
+			Name:  "Grace Hopper",
+			Email: "grace@example.com",
+		},
+	}
+
+	tests := []struct {
+		name     string
+		filters  []interface{}
+		expected bool
+	}{
+		{"name regex", []interface{}{testFilter("name", "^api")}, true},
+		{"name regex mismatch", []interface{}{testFilter("name", "^ui")}, false},
+		{"created by email", []interface{}{testFilter("created_by", "grace@example.com")}, true},
+		{"created by name", []interface{}{testFilter("created_by", "Grace Hopper")}, true},
+		{"created by other", []interface{}{testFilter("created_by", "ada@example.com")}, false},
+		{"id", []interface{}{testFilter("id", test.Id)}, true},
+		{"all filters must pass", []interface{}{testFilter("name", "smoke"), testFilter("created_by", "ada@example.com")}, false},
+		{"any value may match", []interface{}{testFilter("name", "^ui", "^api")}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filters := schema.NewSet(schema.HashResource(dataSourceRunscopeTests().Schema["filter"].Elem.(*schema.Resource)), tt.filters)
+			passed, err := testFiltersTest(test, filters)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if passed != tt.expected {
+				t.Errorf("expected %t, got %t", tt.expected, passed)
+			}
+		})
+	}
+}
+
+func testFilter(name string, values ...string) map[string]interface{} {
+	set := schema.NewSet(schema.HashString, nil)
+	for _, v := range values {
+		set.Add(v)
+	}
+	return map[string]interface{}{"name": name, "values": set}
+}
+
+const testAccDataSourceRunscopeTestsConfig = `
+resource "runscope_bucket" "test" {
+  team_uuid = "%s"
+  name      = "%s"
+}
+
+resource "runscope_test" "api" {
+  bucket_id = runscope_bucket.test.id
+  name      = "api smoke test"
+}
+
+resource "runscope_test" "ui" {
+  bucket_id = runscope_bucket.test.id
+  name      = "ui test"
+}
+
+resource "runscope_step_request" "api" {
+  bucket_id = runscope_bucket.test.id
+  test_id   = runscope_test.api.id
+  method    = "GET"
+  url       = "https://example.com"
+}
+
+data "runscope_tests" "all" {
+  bucket_id = runscope_bucket.test.id
+
+  depends_on = [runscope_test.api, runscope_test.ui]
+}
+
+data "runscope_tests" "api" {
+  bucket_id = runscope_bucket.test.id
+
+  filter {
+    name   = "name"
+    values = ["^api"]
+  }
+
+  depends_on = [runscope_test.api, runscope_test.ui]
+}
+
+data "runscope_test" "api" {
+  bucket_id = runscope_bucket.test.id
+  name      = runscope_test.api.name
+
+  depends_on = [runscope_step_request.api]
+}
+`
+
+const testAccDataSourceRunscopeTestMissingConfig = `
+resource "runscope_bucket" "test" {
+  team_uuid = "%s"
+  name      = "%s"
+}
+
+data "runscope_test" "missing" {
+  bucket_id = runscope_bucket.test.id
+  name      = "no such test"
+}
+`
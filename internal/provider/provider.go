@@ -3,9 +3,16 @@ package provider
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope"
 )
 
@@ -22,9 +29,42 @@ func Provider() *schema.Provider {
 			"api_url": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("RUNSCOPE_API_URL", nil),
+				DefaultFunc: schema.EnvDefaultFunc("RUNSCOPE_API_URL", runscope.DefaultEndpoint),
 				Description: "A runscope api url i.e. https://api.runscope.com.",
-				Default:     "https://api.runscope.com",
+			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      runscope.DefaultMaxRetries,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Maximum number of times a rate limited or failed request is retried.",
+			},
+			"retry_wait_min": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      int(runscope.DefaultRetryWaitMin / time.Second),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Minimum time in seconds to wait between retries.",
+			},
+			"retry_wait_max": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      int(runscope.DefaultRetryWaitMax / time.Second),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Maximum time in seconds to wait between retries. Requests the API asks to retry later than that fail instead.",
+			},
+			"requests_per_second": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.FloatAtLeast(0),
+				Description:  "Maximum number of API requests per second shared by all resources. 0 means no limit.",
+			},
+			"skip_credentials_validation": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("RUNSCOPE_SKIP_CREDENTIALS_VALIDATION", false),
+				Description: "Skip checking the access token against the API when the provider is configured, and the teams of buckets against its account when planning, e.g. to validate configurations offline.",
 			},
 		},
 
@@ -33,17 +73,29 @@ func Provider() *schema.Provider {
 			"runscope_integrations":  dataSourceRunscopeIntegrations(),
 			"runscope_bucket":        dataSourceRunscopeBucket(),
 			"runscope_buckets":       dataSourceRunscopeBuckets(),
+			"runscope_environment":   dataSourceRunscopeEnvironment(),
+			"runscope_environments":  dataSourceRunscopeEnvironments(),
 			"runscope_remote_agents": dataSourceRunscopeRemoteAgents(),
+			"runscope_schedules":     dataSourceRunscopeSchedules(),
 			"runscope_team":          dataSourceRunscopeTeam(),
+			"runscope_test":          dataSourceRunscopeTest(),
+			"runscope_tests":         dataSourceRunscopeTests(),
+			"runscope_test_results":  dataSourceRunscopeTestResults(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"runscope_bucket":       resourceRunscopeBucket(),
-			"runscope_test":         resourceRunscopeTest(),
-			"runscope_environment":  resourceRunscopeEnvironment(),
-			"runscope_schedule":     resourceRunscopeSchedule(),
-			"runscope_step_request": resourceRunscopeStepRequest(),
-			"runscope_step_subtest": resourceRunscopeStepSubtest(),
+			"runscope_bucket":                resourceRunscopeBucket(),
+			"runscope_test":                  resourceRunscopeTest(),
+			"runscope_environment":           resourceRunscopeEnvironment(),
+			"runscope_maintenance_window":    resourceRunscopeMaintenanceWindow(),
+			"runscope_schedule":              resourceRunscopeSchedule(),
+			"runscope_step_request":          resourceRunscopeStepRequest(),
+			"runscope_step_subtest":          resourceRunscopeStepSubtest(),
+			"runscope_step_pause":            resourceRunscopeStepPause(),
+			"runscope_step_condition":        resourceRunscopeStepCondition(),
+			"runscope_step_incoming_request": resourceRunscopeStepIncomingRequest(),
+			"runscope_test_run":              resourceRunscopeTestRun(),
+			"runscope_test_steps":            resourceRunscopeTestSteps(),
 		},
 
 		ConfigureContextFunc: providerConfigure,
@@ -52,24 +104,143 @@ func Provider() *schema.Provider {
 
 type providerConfig struct {
 	client *runscope.Client
+
+	// stepLocks serializes step mutations per test. Runscope answers a
+	// step creation with the full step list and the new step is taken to
+	// be the last one, which only holds if steps of a test are created
+	// one at a time.
+	stepLocks *mutexKV
+
+	// account is the account of the access token, with its teams. It is
+	// read when the provider is configured unless credentials validation
+	// is skipped, and otherwise on first use.
+	account   *runscope.Account
+	accountMu sync.Mutex
 }
 
-func providerConfigure(_ context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+// getAccount returns the account of the access token, reading it only the
+// first time.
+func (c *providerConfig) getAccount(ctx context.Context) (*runscope.Account, error) {
+	c.accountMu.Lock()
+	defer c.accountMu.Unlock()
+
+	if c.account == nil {
+		account, err := c.client.Account.Get(ctx, &runscope.AccountGetOpts{})
+		if err != nil {
+			return nil, err
+		}
+		c.account = account
+	}
+
+	return c.account, nil
+}
+
+func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 	token := d.Get("access_token").(string)
 	endpoint := d.Get("api_url").(string)
+	retryWaitMin := time.Duration(d.Get("retry_wait_min").(int)) * time.Second
+	retryWaitMax := time.Duration(d.Get("retry_wait_max").(int)) * time.Second
+
+	if retryWaitMin > retryWaitMax {
+		return nil, diag.Errorf("retry_wait_min must not be greater than retry_wait_max")
+	}
 
-	client := runscope.NewClient(runscope.WithToken(token), runscope.WithEndpoint(endpoint))
+	client := runscope.NewClient(
+		runscope.WithToken(token),
+		runscope.WithEndpoint(endpoint),
+		runscope.WithMaxRetries(d.Get("max_retries").(int)),
+		runscope.WithRetryWait(retryWaitMin, retryWaitMax),
+		runscope.WithRateLimit(d.Get("requests_per_second").(float64), 0),
+	)
 
-	return &providerConfig{
-		client: client,
-	}, nil
+	config := &providerConfig{
+		client:    client,
+		stepLocks: newMutexKV(),
+	}
+
+	if d.Get("skip_credentials_validation").(bool) {
+		return config, nil
+	}
+
+	if _, err := config.getAccount(ctx); err != nil {
+		if errors.Is(err, runscope.ErrUnauthorized) {
+			return nil, diag.Diagnostics{{
+				Severity:      diag.Error,
+				Summary:       "Invalid Runscope access token",
+				Detail:        fmt.Sprintf("The access token was rejected by %s: %s", endpoint, err),
+				AttributePath: cty.GetAttrPath("access_token"),
+			}}
+		}
+		if errors.Is(err, runscope.ErrForbidden) {
+			return nil, diag.Diagnostics{{
+				Severity:      diag.Error,
+				Summary:       "Runscope access token not permitted to read the account",
+				Detail:        fmt.Sprintf("The access token was refused access to the account by %s: %s", endpoint, err),
+				AttributePath: cty.GetAttrPath("access_token"),
+			}}
+		}
+
+		return nil, diag.Errorf("Couldn't check Runscope credentials against %s: %s", endpoint, err)
+	}
+
+	return config, nil
 }
 
-func isNotFound(err error) bool {
+// apiErrorDiags returns a diagnostic for err prefixed with summary, e.g.
+// "Couldn't create step". When the API rejected fields of the request, each
+// gets a diagnostic of its own pointing at the argument of s it was set
+// from, as far as it can be told, summarized by the message of the API.
+func apiErrorDiags(s map[string]*schema.Schema, summary string, err error) diag.Diagnostics {
 	var runscopeErr runscope.Error
-	if errors.As(err, &runscopeErr) {
-		return runscopeErr.Status() == 404
+	if !errors.As(err, &runscopeErr) || len(runscopeErr.FieldErrors()) == 0 {
+		return diag.Errorf("%s: %s", summary, err)
 	}
 
-	return false
+	if runscopeErr.E.Message != "" {
+		summary = fmt.Sprintf("%s: %s", summary, runscopeErr.E.Message)
+	}
+
+	var diags diag.Diagnostics
+	for _, field := range runscopeErr.FieldErrors() {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       summary,
+			Detail:        fmt.Sprintf("%s: %s", field.Field, field.Message),
+			AttributePath: apiFieldPath(s, field.Field),
+		})
+	}
+	return diags
+}
+
+// apiFieldPath returns the path of the argument of s that the API field,
+// e.g. "assertions.1.value", was set from. Fields are named like the
+// arguments or, for blocks, in plural. The path stops at the last argument
+// found, which is the whole set for fields inside of a set.
+func apiFieldPath(s map[string]*schema.Schema, field string) cty.Path {
+	var path cty.Path
+	parts := strings.Split(field, ".")
+	for i := 0; i < len(parts); i++ {
+		name := parts[i]
+		if _, ok := s[name]; !ok {
+			name = strings.TrimSuffix(name, "s")
+		}
+		attr, ok := s[name]
+		if !ok {
+			break
+		}
+		path = path.GetAttr(name)
+
+		elem, ok := attr.Elem.(*schema.Resource)
+		if !ok || attr.Type != schema.TypeList || i+1 == len(parts) {
+			break
+		}
+		index, err := strconv.Atoi(parts[i+1])
+		if err != nil {
+			break
+		}
+		path = path.IndexInt(index)
+		s = elem.Schema
+		i++
+	}
+	return path
 }
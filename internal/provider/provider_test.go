@@ -2,12 +2,19 @@ package provider
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope"
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope/runscopetest"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -31,6 +38,18 @@ func init() {
 }
 
 func TestMain(m *testing.M) {
+	// Without credentials the acceptance tests run against an in-memory
+	// fake of the Runscope API.
+	if os.Getenv("RUNSCOPE_ACCESS_TOKEN") == "" {
+		server := runscopetest.NewServer()
+		os.Setenv("RUNSCOPE_ACCESS_TOKEN", server.Token)
+		os.Setenv("RUNSCOPE_API_URL", server.URL)
+		os.Setenv("RUNSCOPE_TEAM_ID", server.TeamID())
+
+		// Runs on the fake finish after a couple of polls.
+		testRunPollInterval = 100 * time.Millisecond
+	}
+
 	resource.TestMain(m)
 }
 
@@ -45,6 +64,113 @@ func TestProviderImpl(t *testing.T) {
 	var _ = Provider()
 }
 
+func TestProviderConfigure(t *testing.T) {
+	ctx := context.Background()
+	server := runscopetest.NewServer()
+	defer server.Close()
+
+	configure := func(raw map[string]interface{}) (interface{}, diag.Diagnostics) {
+		raw["api_url"] = server.URL
+		d := schema.TestResourceDataRaw(t, Provider().Schema, raw)
+		return providerConfigure(ctx, d)
+	}
+
+	meta, diags := configure(map[string]interface{}{"access_token": server.Token})
+	if diags.HasError() {
+		t.Fatal(diags[0].Summary)
+	}
+	account := meta.(*providerConfig).account
+	if account == nil || len(account.Teams) != 1 || account.Teams[0].UUID != server.TeamID() {
+		t.Errorf("expected the account and its teams to be cached, got %+v", account)
+	}
+
+	_, diags = configure(map[string]interface{}{"access_token": "invalid"})
+	if !diags.HasError() {
+		t.Fatal("expected an invalid access token to fail")
+	}
+	if diags[0].Summary != "Invalid Runscope access token" || !diags[0].AttributePath.Equals(cty.GetAttrPath("access_token")) {
+		t.Errorf("unexpected diagnostic %+v", diags[0])
+	}
+
+	meta, diags = configure(map[string]interface{}{
+		"access_token":                "invalid",
+		"skip_credentials_validation": true,
+	})
+	if diags.HasError() {
+		t.Fatalf("expected validation to be skipped, got %s", diags[0].Summary)
+	}
+	if meta.(*providerConfig).account != nil {
+		t.Error("expected no account to be read")
+	}
+
+	forbidden := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"error": {"status": 403, "message": "Insufficient scope."}}`))
+	}))
+	defer forbidden.Close()
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"access_token": server.Token,
+		"api_url":      forbidden.URL,
+		"max_retries":  0,
+	})
+	_, diags = providerConfigure(ctx, d)
+	if !diags.HasError() {
+		t.Fatal("expected a forbidden account to fail")
+	}
+	if diags[0].Summary != "Runscope access token not permitted to read the account" {
+		t.Errorf("unexpected diagnostic %+v", diags[0])
+	}
+}
+
+func TestApiErrorDiags(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"error": {"status": 400, "message": "Invalid request.", "errors": {
+			"url": ["Not a valid URL."],
+			"assertions": {"1": {"comparison": ["Not a valid comparison."]}},
+			"headers": {"Accept": ["Duplicate header."]},
+			"frobnicate": ["Unknown field."]
+		}}}`))
+	}))
+	defer server.Close()
+
+	meta := &providerConfig{
+		client:    runscope.NewClient(runscope.WithEndpoint(server.URL), runscope.WithMaxRetries(0)),
+		stepLocks: newMutexKV(),
+	}
+	d := schema.TestResourceDataRaw(t, resourceRunscopeStepRequest().Schema, map[string]interface{}{
+		"bucket_id": "bucket",
+		"test_id":   "test",
+		"method":    "GET",
+		"url":       "not a url",
+	})
+
+	diags := resourceStepRequestCreate(context.Background(), d, meta)
+
+	expected := map[string]cty.Path{
+		"assertions.1.comparison: Not a valid comparison.": cty.GetAttrPath("assertion").IndexInt(1).GetAttr("comparison"),
+		"frobnicate: Unknown field.":                       nil,
+		"headers.Accept: Duplicate header.":                cty.GetAttrPath("header"),
+		"url: Not a valid URL.":                            cty.GetAttrPath("url"),
+	}
+	if len(diags) != len(expected) {
+		t.Fatalf("expected %d diagnostics, got %v", len(expected), diags)
+	}
+	for _, d := range diags {
+		path, ok := expected[d.Detail]
+		if !ok {
+			t.Errorf("unexpected diagnostic %s", d.Detail)
+			continue
+		}
+		if d.Summary != "Couldn't create step: Invalid request." {
+			t.Errorf("unexpected summary %s", d.Summary)
+		}
+		if !d.AttributePath.Equals(path) {
+			t.Errorf("expected %s to point at %#v, got %#v", d.Detail, path, d.AttributePath)
+		}
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
 	ctx := context.TODO()
 
@@ -62,6 +188,19 @@ func testAccPreCheck(t *testing.T) {
 	}
 }
 
+// testProviderConfig returns a provider configuration talking to the given
+// fake Runscope API.
+func testProviderConfig(server *runscopetest.Server) *providerConfig {
+	return &providerConfig{
+		client: runscope.NewClient(
+			runscope.WithEndpoint(server.URL),
+			runscope.WithToken(server.Token),
+			runscope.WithMaxRetries(0),
+		),
+		stepLocks: newMutexKV(),
+	}
+}
+
 func testAccRandomBucketName() string {
 	return acctest.RandomWithPrefix("terraform-runscope-testacc")
 }
@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"io"
+	"mime"
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// suppressEquivalentBody suppresses diffs of a step's request body that
+// are equivalent for the Content-Type header of the step, such as JSON
+// serialized with other spacing or key order. Bodies that can't be parsed,
+// e.g. because they contain {{variables}}, are compared as is.
+func suppressEquivalentBody(_, old, new string, d *schema.ResourceData) bool {
+	if old == new {
+		return true
+	}
+
+	var headers []interface{}
+	if v, ok := d.GetOk("header"); ok {
+		headers = v.(*schema.Set).List()
+	}
+
+	return equivalentBodies(contentType(headers), old, new)
+}
+
+// contentType returns the media type of the Content-Type header among
+// headers, matching its name regardless of case.
+func contentType(headers []interface{}) string {
+	for _, header := range headers {
+		h := header.(map[string]interface{})
+		if !strings.EqualFold(h["header"].(string), "Content-Type") {
+			continue
+		}
+		mediaType, _, err := mime.ParseMediaType(h["value"].(string))
+		if err != nil {
+			return ""
+		}
+		return mediaType
+	}
+	return ""
+}
+
+// equivalentBodies reports whether two bodies of the given media type mean
+// the same.
+func equivalentBodies(mediaType, a, b string) bool {
+	var normalize func(string) (interface{}, error)
+	switch {
+	case mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"):
+		normalize = normalizeJSON
+	case mediaType == "application/xml" || mediaType == "text/xml" || strings.HasSuffix(mediaType, "+xml"):
+		normalize = normalizeXML
+	case mediaType == "application/x-www-form-urlencoded":
+		normalize = normalizeForm
+	default:
+		return a == b
+	}
+
+	na, err := normalize(a)
+	if err != nil {
+		return a == b
+	}
+	nb, err := normalize(b)
+	if err != nil {
+		return a == b
+	}
+	return reflect.DeepEqual(na, nb)
+}
+
+func normalizeJSON(body string) (interface{}, error) {
+	decoder := json.NewDecoder(strings.NewReader(body))
+	// Numbers are kept as written, so that large numbers aren't rounded
+	// into being equal.
+	decoder.UseNumber()
+
+	var v interface{}
+	if err := decoder.Decode(&v); err != nil {
+		return nil, err
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, errors.New("unexpected data after JSON value")
+	}
+	return v, nil
+}
+
+// normalizeXML returns the tokens of an XML document with attributes
+// sorted, leaving out whitespace between elements. Everything else,
+// including comments, is kept as is.
+func normalizeXML(body string) (interface{}, error) {
+	decoder := xml.NewDecoder(strings.NewReader(body))
+
+	var tokens []interface{}
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			attrs := append([]xml.Attr{}, t.Attr...)
+			sort.Slice(attrs, func(i, j int) bool {
+				if attrs[i].Name.Space != attrs[j].Name.Space {
+					return attrs[i].Name.Space < attrs[j].Name.Space
+				}
+				return attrs[i].Name.Local < attrs[j].Name.Local
+			})
+			tokens = append(tokens, xml.StartElement{Name: t.Name, Attr: attrs})
+		case xml.CharData:
+			if len(bytes.TrimSpace(t)) > 0 {
+				tokens = append(tokens, string(t))
+			}
+		default:
+			tokens = append(tokens, xml.CopyToken(token))
+		}
+	}
+	if len(tokens) == 0 {
+		return nil, errors.New("empty XML document")
+	}
+	return tokens, nil
+}
+
+// normalizeForm returns the parameters of a form, whose order between
+// different names doesn't matter.
+func normalizeForm(body string) (interface{}, error) {
+	values, err := url.ParseQuery(strings.TrimSpace(body))
+	if err != nil {
+		return nil, err
+	}
+	return map[string][]string(values), nil
+}
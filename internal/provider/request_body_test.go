@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestEquivalentBodies(t *testing.T) {
+	tests := []struct {
+		name      string
+		mediaType string
+		a, b      string
+		expected  bool
+	}{
+		{"json spacing and key order", "application/json", `{"a": 1, "b": [1, 2]}`, `{"b":[1,2],"a":1}`, true},
+		{"json different values", "application/json", `{"a": 1}`, `{"a": 2}`, false},
+		{"json array order", "application/json", `[1, 2]`, `[2, 1]`, false},
+		{"json large numbers", "application/json", `{"a": 12345678901234567890}`, `{"a": 12345678901234567891}`, false},
+		{"json suffix", "application/vnd.api+json", `{"a":1}`, `{ "a": 1 }`, true},
+		{"json with variables", "application/json", `{"a": {{id}}}`, `{"a":{{id}}}`, false},
+		{"json trailing data", "application/json", `{"a": 1} x`, `{"a": 1}`, false},
+		{"xml whitespace and attribute order", "application/xml", "<a x=\"1\" y=\"2\">\n  <b>text</b>\n</a>", `<a y="2" x="1"><b>text</b></a>`, true},
+		{"xml text", "text/xml", `<a>one</a>`, `<a>two</a>`, false},
+		{"xml text spacing", "application/xml", `<a> one</a>`, `<a>one</a>`, false},
+		{"xml invalid", "application/xml", `<a>`, `<a/>`, false},
+		{"form parameter order", "application/x-www-form-urlencoded", "a=1&b=2", "b=2&a=1", true},
+		{"form encoding", "application/x-www-form-urlencoded", "a=hello%20world", "a=hello+world", true},
+		{"form repeated parameter order", "application/x-www-form-urlencoded", "a=1&a=2", "a=2&a=1", false},
+		{"plain text", "text/plain", `{"a": 1}`, `{"a":1}`, false},
+		{"no content type", "", `{"a": 1}`, `{"a":1}`, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if actual := equivalentBodies(test.mediaType, test.a, test.b); actual != test.expected {
+				t.Errorf("expected %t, got %t", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestSuppressEquivalentBody(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceRunscopeStepRequest().Schema, map[string]interface{}{
+		"bucket_id": "bucket",
+		"test_id":   "test",
+		"method":    "POST",
+		"url":       "https://example.com",
+		"header": []interface{}{map[string]interface{}{
+			"header": "content-type",
+			"value":  "application/json; charset=utf-8",
+		}},
+	})
+
+	if !suppressEquivalentBody("body", `{"a": 1}`, `{"a":1}`, d) {
+		t.Error("expected equivalent JSON bodies to be suppressed")
+	}
+	if suppressEquivalentBody("body", `{"a": 1}`, `{"a": 2}`, d) {
+		t.Error("expected different JSON bodies not to be suppressed")
+	}
+}
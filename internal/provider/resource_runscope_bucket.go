@@ -2,8 +2,10 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope"
@@ -17,6 +19,7 @@ func resourceRunscopeBucket() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceBucketImport,
 		},
+		CustomizeDiff: customizeBucketDiff,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -50,6 +53,32 @@ func resourceRunscopeBucket() *schema.Resource {
 	}
 }
 
+// customizeBucketDiff checks that team_uuid is one of the teams of the
+// account read when the provider was configured, so that a wrong team
+// fails the plan rather than the apply. Nothing is checked when the
+// account wasn't read, e.g. as credentials validation was skipped.
+func customizeBucketDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	config, ok := meta.(*providerConfig)
+	if !ok || !d.HasChange("team_uuid") || !d.NewValueKnown("team_uuid") {
+		return nil
+	}
+
+	config.accountMu.Lock()
+	account := config.account
+	config.accountMu.Unlock()
+	if account == nil {
+		return nil
+	}
+
+	teamUUID := d.Get("team_uuid").(string)
+	for _, team := range account.Teams {
+		if team.UUID == teamUUID {
+			return nil
+		}
+	}
+	return cty.GetAttrPath("team_uuid").NewErrorf("team_uuid: %s is not a team of the account of the access token", teamUUID)
+}
+
 func resourceBucketCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*providerConfig).client
 
@@ -60,7 +89,7 @@ func resourceBucketCreate(ctx context.Context, d *schema.ResourceData, meta inte
 
 	bucket, err := client.Bucket.Create(ctx, opts)
 	if err != nil {
-		return diag.Errorf("Failed to create bucket: %s", err)
+		return apiErrorDiags(resourceRunscopeBucket().Schema, "Failed to create bucket", err)
 	}
 
 	d.SetId(bucket.Key)
@@ -74,7 +103,7 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 	opts := &runscope.BucketGetOpts{Key: d.Id()}
 	bucket, err := client.Bucket.Get(ctx, opts)
 	if err != nil {
-		if isNotFound(err) {
+		if errors.Is(err, runscope.ErrNotFound) {
 			d.SetId("")
 			return nil
 		}
@@ -8,8 +8,11 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope/runscopetest"
 )
 
 func init() {
@@ -123,3 +126,46 @@ func testAccSweepBuckets(_ string) error {
 
 	return nil
 }
+
+func TestBucket_teamValidation(t *testing.T) {
+	ctx := context.Background()
+	server := runscopetest.NewServer()
+	defer server.Close()
+
+	configure := func(skip bool) interface{} {
+		d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+			"access_token":                server.Token,
+			"api_url":                     server.URL,
+			"skip_credentials_validation": skip,
+		})
+		meta, diags := providerConfigure(ctx, d)
+		if diags.HasError() {
+			t.Fatal(diags[0].Summary)
+		}
+		return meta
+	}
+	diff := func(meta interface{}, teamUUID string) error {
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"name":      "bucket",
+			"team_uuid": teamUUID,
+		})
+		_, err := resourceRunscopeBucket().Diff(ctx, nil, config, meta)
+		return err
+	}
+
+	meta := configure(false)
+	if err := diff(meta, server.TeamID()); err != nil {
+		t.Errorf("expected the team of the account to be accepted, got %s", err)
+	}
+	err := diff(meta, "00000000-0000-0000-0000-000000000000")
+	if err == nil || !strings.Contains(err.Error(), "is not a team of the account") {
+		t.Errorf("expected an unknown team to be rejected, got %v", err)
+	}
+	if pathErr, ok := err.(cty.PathError); !ok || !pathErr.Path.Equals(cty.GetAttrPath("team_uuid")) {
+		t.Errorf("expected the error at team_uuid, got %#v", err)
+	}
+
+	if err := diff(configure(true), "00000000-0000-0000-0000-000000000000"); err != nil {
+		t.Errorf("expected teams not to be checked when credentials validation is skipped, got %s", err)
+	}
+}
@@ -2,6 +2,9 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,6 +18,9 @@ func resourceRunscopeEnvironment() *schema.Resource {
 		ReadContext:   resourceEnvironmentRead,
 		UpdateContext: resourceEnvironmentUpdate,
 		DeleteContext: resourceEnvironmentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceEnvironmentImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"bucket_id": {
@@ -38,6 +44,7 @@ func resourceRunscopeEnvironment() *schema.Resource {
 			"header": {
 				Type:     schema.TypeSet,
 				Optional: true,
+				Set:      hashHeader,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"header": {
@@ -157,8 +164,10 @@ func resourceRunscopeEnvironment() *schema.Resource {
 				Optional: true,
 			},
 			"client_certificate": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The client certificate to send requests with. Stored in the Terraform state like any other argument, as write-only arguments aren't supported; keep the state somewhere safe.",
 			},
 		},
 	}
@@ -176,7 +185,7 @@ func resourceEnvironmentCreate(ctx context.Context, d *schema.ResourceData, meta
 	expandEnvironmentBase(d, &opts.EnvironmentBase)
 	env, err := client.Environment.Create(ctx, &opts)
 	if err != nil {
-		return diag.Errorf("Couldn't create environment: %s", err)
+		return apiErrorDiags(resourceRunscopeEnvironment().Schema, "Couldn't create environment", err)
 	}
 
 	d.SetId(env.Id)
@@ -197,7 +206,7 @@ func resourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta i
 
 	env, err := client.Environment.Get(ctx, &opts)
 	if err != nil {
-		if isNotFound(err) {
+		if errors.Is(err, runscope.ErrNotFound) {
 			d.SetId("")
 			return nil
 		}
@@ -209,9 +218,12 @@ func resourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta i
 	d.Set("test_id", opts.TestId)
 	d.Set("name", env.Name)
 	d.Set("script", env.Script)
+	d.Set("header", flattenStepHeaders(env.Headers))
 	d.Set("preserve_cookies", env.PreserveCookies)
 	d.Set("initial_variables", env.InitialVariables)
 	d.Set("integrations", env.Integrations)
+	d.Set("regions", flattenEnvironmentRegions(d, env.Regions))
+	d.Set("remote_agent", flattenEnvironmentRemoteAgents(env.RemoteAgents))
 	d.Set("retry_on_failure", env.RetryOnFailure)
 	d.Set("stop_on_failure", env.StopOnFailure)
 	d.Set("verify_ssl", env.VerifySSL)
@@ -220,11 +232,39 @@ func resourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta i
 		d.Set("email", flattenEmails(env.Emails))
 	}
 	d.Set("parent_environment_id", env.ParentEnvironmentId)
-	d.Set("client_certificate", env.ClientCertificate)
+	d.Set("client_certificate", keepRedacted(d.Get("client_certificate").(string), env.ClientCertificate))
 
 	return nil
 }
 
+func resourceEnvironmentImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	switch len(parts) {
+	case 2:
+		d.Set("bucket_id", parts[0])
+		d.SetId(parts[1])
+	case 3:
+		d.Set("bucket_id", parts[0])
+		d.Set("test_id", parts[1])
+		d.SetId(parts[2])
+	default:
+		return nil, fmt.Errorf("environment ID for import should be in format bucket_id/environment_id " +
+			"or bucket_id/test_id/environment_id")
+	}
+
+	id := d.Id()
+
+	if diags := resourceEnvironmentRead(ctx, d, meta); diags.HasError() {
+		return nil, fmt.Errorf("%s", diags[0].Summary)
+	}
+
+	if d.Id() == "" {
+		return nil, fmt.Errorf("couldn't find environment: %s", id)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*providerConfig).client
 
@@ -237,7 +277,7 @@ func resourceEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, meta
 	expandEnvironmentBase(d, &opts.EnvironmentBase)
 
 	if _, err := client.Environment.Update(ctx, &opts); err != nil {
-		return diag.Errorf("Couldn't update environment: %s", err)
+		return apiErrorDiags(resourceRunscopeEnvironment().Schema, "Couldn't update environment", err)
 	}
 
 	return resourceEnvironmentRead(ctx, d, meta)
@@ -277,6 +317,17 @@ func flattenRecipients(re []runscope.Recipient) []map[string]interface{} {
 	return recipients
 }
 
+func flattenEnvironmentRemoteAgents(ra []runscope.EnvironmentRemoteAgent) []map[string]interface{} {
+	remoteAgents := make([]map[string]interface{}, len(ra))
+	for i, r := range ra {
+		remoteAgents[i] = map[string]interface{}{
+			"name": r.Name,
+			"uuid": r.UUID,
+		}
+	}
+	return remoteAgents
+}
+
 func recipientsHash(v interface{}) int {
 	m := v.(map[string]interface{})
 	return schema.HashString(m["id"].(string))
@@ -381,3 +432,17 @@ func validateEnvironmentSchema(d *schema.ResourceData) diag.Diagnostics {
 
 	return diag.Errorf("parent_environment_id could be set only if test_id defined")
 }
+
+// flattenEnvironmentRegions returns the regions of an environment, leaving
+// out the region the API runs environments without regions in unless
+// regions are set, so that not setting them shows no drift.
+func flattenEnvironmentRegions(d *schema.ResourceData, regions []string) []string {
+	if _, ok := d.GetOk("regions"); !ok && len(regions) == 1 && regions[0] == defaultEnvironmentRegion {
+		return nil
+	}
+	return regions
+}
+
+// defaultEnvironmentRegion is the region environments created without
+// regions run in.
+const defaultEnvironmentRegion = "us1"
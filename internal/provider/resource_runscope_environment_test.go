@@ -8,7 +8,9 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope/runscopetest"
 )
 
 func TestAccEnvironment_create_default_shared_environment(t *testing.T) {
@@ -21,6 +23,7 @@ func TestAccEnvironment_create_default_shared_environment(t *testing.T) {
 		CheckDestroy:      testAccCheckEnvironmentDestroy,
 		Steps: []resource.TestStep{
 			testAccEnvironmentDefaultConfigStep(testAccEnvironmentSharedDefaultConfig, bucketId, teamId, &environment),
+			testAccEnvironmentImportStep("runscope_environment.environment"),
 		},
 	})
 }
@@ -146,10 +149,66 @@ func TestAccEnvironment_create_nested_test_environment(t *testing.T) {
 		CheckDestroy:      testAccCheckEnvironmentDestroy,
 		Steps: []resource.TestStep{
 			testAccEnvironmentDefaultConfigStep(testAccEnvironmentTestNestedConfig, bucketId, teamId, &environment),
+			testAccEnvironmentImportStep("runscope_environment.environment"),
+			testAccEnvironmentImportStep("runscope_environment.environment_child"),
 		},
 	})
 }
 
+func TestEnvironment_defaultRegions(t *testing.T) {
+	ctx := context.Background()
+	server := runscopetest.NewServer()
+	defer server.Close()
+	meta := testProviderConfig(server)
+
+	bucket, err := meta.client.Bucket.Create(ctx, &runscope.BucketCreateOpts{Name: "bucket", TeamUUID: server.TeamID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The API reports environments created without regions as running
+	// in the default region.
+	opts := &runscope.EnvironmentCreateOpts{}
+	opts.BucketId = bucket.Key
+	opts.Name = "staging"
+	opts.Regions = []string{defaultEnvironmentRegion}
+	opts.VerifySSL = true
+	env, err := meta.client.Environment.Create(ctx, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	raw := map[string]interface{}{
+		"bucket_id": bucket.Key,
+		"name":      "staging",
+	}
+	r := resourceRunscopeEnvironment()
+	d := schema.TestResourceDataRaw(t, r.Schema, raw)
+	d.SetId(env.Id)
+	if diags := resourceEnvironmentRead(ctx, d, meta); diags.HasError() {
+		t.Fatal(diags[0].Summary)
+	}
+
+	diff, err := r.Diff(ctx, d.State(), terraform.NewResourceConfigRaw(raw), meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !diff.Empty() {
+		t.Errorf("expected no changes, got %v", diff.Attributes)
+	}
+
+	// Configured regions are read as they are.
+	raw["regions"] = []interface{}{defaultEnvironmentRegion}
+	d = schema.TestResourceDataRaw(t, r.Schema, raw)
+	d.SetId(env.Id)
+	if diags := resourceEnvironmentRead(ctx, d, meta); diags.HasError() {
+		t.Fatal(diags[0].Summary)
+	}
+	if regions := d.Get("regions").(*schema.Set).List(); len(regions) != 1 || regions[0] != defaultEnvironmentRegion {
+		t.Errorf("expected configured regions to be read, got %v", regions)
+	}
+}
+
 func testAccCheckEnvironmentDestroy(s *terraform.State) error {
 	ctx := context.Background()
 	client := testAccProvider.Meta().(*providerConfig).client
@@ -248,6 +307,24 @@ func testAccEnvironmentDefaultConfigStep(config, bucketId, teamId string, enviro
 	}
 }
 
+func testAccEnvironmentImportStep(n string) resource.TestStep {
+	return resource.TestStep{
+		ResourceName:      n,
+		ImportState:       true,
+		ImportStateVerify: true,
+		ImportStateIdFunc: func(s *terraform.State) (string, error) {
+			rs, ok := s.RootModule().Resources[n]
+			if !ok {
+				return "", fmt.Errorf("not found: %s", n)
+			}
+			if testId := rs.Primary.Attributes["test_id"]; testId != "" {
+				return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["bucket_id"], testId, rs.Primary.ID), nil
+			}
+			return fmt.Sprintf("%s/%s", rs.Primary.Attributes["bucket_id"], rs.Primary.ID), nil
+		},
+	}
+}
+
 func testAccEnvironmentCustomConfigStep(config, bucketId, teamId, recipientId, recipientName, recipientEmail string, environment *runscope.Environment) resource.TestStep {
 	return resource.TestStep{
 		Config: fmt.Sprintf(config, bucketId, teamId, recipientId),
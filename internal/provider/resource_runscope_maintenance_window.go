@@ -0,0 +1,281 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope"
+)
+
+func resourceRunscopeMaintenanceWindow() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceMaintenanceWindowCreate,
+		ReadContext:   resourceMaintenanceWindowRead,
+		UpdateContext: resourceMaintenanceWindowUpdate,
+		DeleteContext: resourceMaintenanceWindowDelete,
+		CustomizeDiff: customizeMaintenanceWindowDiff,
+		Schema: map[string]*schema.Schema{
+			"bucket_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The bucket whose schedules to suspend.",
+			},
+			"test_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Only suspend the schedules of this test. The schedules of every test of the bucket are suspended when not set.",
+			},
+			"exclude_schedule_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of schedules to leave running. Schedules managed by `runscope_schedule` resources must be listed here.",
+			},
+			"starts_at": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+				Description:  "When the window starts, in RFC 3339 format. Schedules are suspended by the first apply after this time, or right away when not set.",
+			},
+			"ends_at": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+				Description:  "When the window ends, in RFC 3339 format. Schedules are restored by the first apply after this time.",
+			},
+			"active": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether all the schedules are suspended.",
+			},
+			"schedules": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The suspended schedules with the settings they are restored with.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"test_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"schedule_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"environment_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"interval": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"note": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+		Description: "Suspends the schedules of a test or bucket during a time range, " +
+			"restoring their interval, environment and note afterwards.\n\n" +
+			"The window only takes effect when Terraform is applied: nothing happens when `starts_at` or `ends_at` passes. " +
+			"Schedules are suspended by the first apply after the window starts and restored by the first apply after it ends, " +
+			"or when the resource is destroyed. Until then, every plan shows `active` changing. " +
+			"Run apply at the start and end of the window, e.g. from a scheduled CI job.\n\n" +
+			"Suspending a schedule deletes it, and restoring it creates a new schedule with the same settings. " +
+			"Restored schedules have new IDs: anything referring to a schedule by ID, in Terraform or elsewhere, " +
+			"no longer finds it after the window. A `runscope_schedule` resource can't follow that: it would create " +
+			"its schedule again during the window and duplicate it afterwards. Leave the schedules it manages running " +
+			"with `exclude_schedule_ids`, and remove those resources to suspend them instead.\n\n" +
+			"If suspending fails partway, the schedules deleted so far are kept in state and `active` stays false, " +
+			"so that the next apply suspends the rest, or restores them if the window has ended.",
+	}
+}
+
+// customizeMaintenanceWindowDiff plans suspending or restoring the schedules
+// when the window has started or ended since the last apply, and restoring
+// schedules left suspended by a failed apply once it isn't active.
+func customizeMaintenanceWindowDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("starts_at") || !d.NewValueKnown("ends_at") {
+		return nil
+	}
+
+	startsAt, endsAt := maintenanceWindowTimes(d.Get("starts_at").(string), d.Get("ends_at").(string))
+	if !startsAt.IsZero() && !endsAt.After(startsAt) {
+		return fmt.Errorf("ends_at must be after starts_at")
+	}
+
+	active := maintenanceWindowActive(startsAt, endsAt, time.Now())
+	pending := !active && len(d.Get("schedules").([]interface{})) > 0
+	if d.Id() != "" && d.Get("active").(bool) == active && !pending {
+		return nil
+	}
+
+	if err := d.SetNew("active", active); err != nil {
+		return err
+	}
+	return d.SetNewComputed("schedules")
+}
+
+func maintenanceWindowTimes(startsAt, endsAt string) (time.Time, time.Time) {
+	// Both are validated to be RFC 3339 times, or empty.
+	starts, _ := time.Parse(time.RFC3339, startsAt)
+	ends, _ := time.Parse(time.RFC3339, endsAt)
+	return starts, ends
+}
+
+// maintenanceWindowActive reports whether now is within the window. A zero
+// start means the window has started.
+func maintenanceWindowActive(startsAt, endsAt, now time.Time) bool {
+	return !now.Before(startsAt) && now.Before(endsAt)
+}
+
+func resourceMaintenanceWindowCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(fmt.Sprintf("%s/%s", d.Get("bucket_id").(string), time.Now().UTC().Format(time.RFC3339Nano)))
+	d.Set("schedules", []interface{}{})
+
+	if d.Get("active").(bool) {
+		return suspendSchedules(ctx, d, meta, nil)
+	}
+
+	return nil
+}
+
+func resourceMaintenanceWindowRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The suspended schedules only exist in state, so there is nothing to
+	// refresh; whether the window is active is decided when planning.
+	return nil
+}
+
+func resourceMaintenanceWindowUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The schedules are planned as computed when the window starts or
+	// ends, so the suspended ones are only known from the prior state.
+	suspended, _ := d.GetChange("schedules")
+
+	switch {
+	case d.Get("active").(bool) && d.HasChange("active"):
+		return suspendSchedules(ctx, d, meta, suspended.([]interface{}))
+	case !d.Get("active").(bool):
+		return restoreSchedules(ctx, d, meta, suspended.([]interface{}))
+	}
+	return nil
+}
+
+func resourceMaintenanceWindowDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return restoreSchedules(ctx, d, meta, d.Get("schedules").([]interface{}))
+}
+
+// suspendSchedules deletes the schedules of the window's tests, adding
+// their settings to suspended in state. The window only becomes active
+// once every schedule is suspended. After a failure, the schedules deleted
+// so far are kept in state and the window stays inactive, so that the next
+// apply suspends the rest, or restores them if the window has ended.
+func suspendSchedules(ctx context.Context, d *schema.ResourceData, meta interface{}, suspended []interface{}) diag.Diagnostics {
+	client := meta.(*providerConfig).client
+	bucketId := d.Get("bucket_id").(string)
+	excluded := d.Get("exclude_schedule_ids").(*schema.Set)
+
+	testIds := []string{d.Get("test_id").(string)}
+	if testIds[0] == "" {
+		tests, err := client.Test.List(ctx, runscope.TestListOpts{BucketId: bucketId})
+		if err != nil {
+			d.Set("schedules", suspended)
+			d.Set("active", false)
+			return diag.Errorf("Couldn't list tests of bucket %s: %s", bucketId, err)
+		}
+
+		testIds = make([]string, len(tests))
+		for i, test := range tests {
+			testIds[i] = test.Id
+		}
+	}
+
+	fail := func(format string, a ...interface{}) diag.Diagnostics {
+		d.Set("schedules", suspended)
+		d.Set("active", false)
+		return diag.Errorf(format, a...)
+	}
+
+	for _, testId := range testIds {
+		listOpts := &runscope.ScheduleListOpts{}
+		listOpts.BucketId = bucketId
+		listOpts.TestId = testId
+
+		schedules, err := client.Schedule.List(ctx, listOpts)
+		if err != nil {
+			return fail("Couldn't list schedules of test %s: %s", testId, err)
+		}
+
+		for _, schedule := range schedules {
+			if excluded.Contains(schedule.Id) {
+				continue
+			}
+
+			tflog.Debug(ctx, "Suspending schedule", map[string]interface{}{"test_id": testId, "schedule_id": schedule.Id})
+
+			deleteOpts := &runscope.ScheduleDeleteOpts{}
+			deleteOpts.ScheduleURLOpts = listOpts.ScheduleURLOpts
+			deleteOpts.Id = schedule.Id
+			if err := client.Schedule.Delete(ctx, deleteOpts); err != nil {
+				return fail("Couldn't suspend schedule %s of test %s: %s", schedule.Id, testId, err)
+			}
+
+			suspended = append(suspended, map[string]interface{}{
+				"test_id":        testId,
+				"schedule_id":    schedule.Id,
+				"environment_id": schedule.EnvironmentId,
+				"interval":       flattenScheduleInterval(schedule.Interval),
+				"note":           schedule.Note,
+			})
+		}
+	}
+
+	d.Set("schedules", suspended)
+	d.Set("active", true)
+	return nil
+}
+
+// restoreSchedules creates the suspended schedules again. Schedules not yet
+// restored when one fails are kept in state to be restored later.
+func restoreSchedules(ctx context.Context, d *schema.ResourceData, meta interface{}, suspended []interface{}) diag.Diagnostics {
+	client := meta.(*providerConfig).client
+	bucketId := d.Get("bucket_id").(string)
+
+	defer func() {
+		d.Set("schedules", suspended)
+		d.Set("active", len(suspended) > 0)
+	}()
+
+	for len(suspended) > 0 {
+		s := suspended[0].(map[string]interface{})
+		testId := s["test_id"].(string)
+
+		opts := &runscope.ScheduleCreateOpts{}
+		opts.BucketId = bucketId
+		opts.TestId = testId
+		opts.EnvironmentId = s["environment_id"].(string)
+		opts.Interval = s["interval"].(string)
+		opts.Note = s["note"].(string)
+
+		tflog.Debug(ctx, "Restoring schedule", map[string]interface{}{"test_id": testId, "schedule_id": s["schedule_id"]})
+		if _, err := client.Schedule.Create(ctx, opts); err != nil && !errors.Is(err, runscope.ErrNotFound) {
+			return diag.Errorf("Couldn't restore schedule %s of test %s: %s", s["schedule_id"], testId, err)
+		}
+		// Schedules of tests deleted in the meantime are dropped.
+
+		suspended = suspended[1:]
+	}
+
+	return nil
+}
@@ -0,0 +1,337 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope"
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope/runscopetest"
+)
+
+func TestMaintenanceWindow_suspend(t *testing.T) {
+	ctx := context.Background()
+	server := runscopetest.NewServer()
+	defer server.Close()
+	meta := testProviderConfig(server)
+
+	bucket, err := meta.client.Bucket.Create(ctx, &runscope.BucketCreateOpts{Name: "bucket", TeamUUID: server.TeamID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests []*runscope.Test
+	for i := 0; i < 2; i++ {
+		test, err := meta.client.Test.Create(ctx, runscope.TestCreateOpts{BucketId: bucket.Key})
+		if err != nil {
+			t.Fatal(err)
+		}
+		opts := &runscope.ScheduleCreateOpts{}
+		opts.BucketId = bucket.Key
+		opts.TestId = test.Id
+		opts.EnvironmentId = test.DefaultEnvironmentId
+		opts.Interval = "1h"
+		opts.Note = "hourly"
+		if _, err := meta.client.Schedule.Create(ctx, opts); err != nil {
+			t.Fatal(err)
+		}
+		tests = append(tests, test)
+	}
+
+	listSchedules := func(testId string) []*runscope.Schedule {
+		opts := &runscope.ScheduleListOpts{}
+		opts.BucketId = bucket.Key
+		opts.TestId = testId
+		schedules, err := meta.client.Schedule.List(ctx, opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return schedules
+	}
+
+	for _, tc := range []struct {
+		name      string
+		testId    string
+		suspended []string
+	}{
+		{"test", tests[0].Id, []string{tests[0].Id}},
+		{"bucket", "", []string{tests[0].Id, tests[1].Id}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceRunscopeMaintenanceWindow().Schema, map[string]interface{}{
+				"bucket_id": bucket.Key,
+				"test_id":   tc.testId,
+				"ends_at":   "2030-01-01T00:00:00Z",
+			})
+			d.Set("active", true)
+
+			if diags := resourceMaintenanceWindowCreate(ctx, d, meta); diags.HasError() {
+				t.Fatal(diags[0].Summary)
+			}
+
+			schedules := d.Get("schedules").([]interface{})
+			if len(schedules) != len(tc.suspended) {
+				t.Fatalf("expected %d suspended schedules, got %v", len(tc.suspended), schedules)
+			}
+			for _, testId := range tc.suspended {
+				if s := listSchedules(testId); len(s) != 0 {
+					t.Errorf("expected schedules of test %s to be suspended, got %v", testId, s)
+				}
+			}
+			s := schedules[0].(map[string]interface{})
+			if s["interval"] != "1h" || s["note"] != "hourly" || s["environment_id"] == "" {
+				t.Errorf("unexpected suspended schedule %v", s)
+			}
+
+			if diags := resourceMaintenanceWindowDelete(ctx, d, meta); diags.HasError() {
+				t.Fatal(diags[0].Summary)
+			}
+
+			if d.Get("active").(bool) || len(d.Get("schedules").([]interface{})) != 0 {
+				t.Errorf("expected no suspended schedules left, got %v", d.Get("schedules"))
+			}
+			for _, test := range tests {
+				s := listSchedules(test.Id)
+				if len(s) != 1 {
+					t.Fatalf("expected schedule of test %s to be restored, got %v", test.Id, s)
+				}
+				if s[0].EnvironmentId != test.DefaultEnvironmentId || s[0].Interval != "1.0h" || s[0].Note != "hourly" {
+					t.Errorf("unexpected restored schedule %+v", s[0])
+				}
+			}
+		})
+	}
+}
+
+func TestMaintenanceWindow_end(t *testing.T) {
+	ctx := context.Background()
+	server := runscopetest.NewServer()
+	defer server.Close()
+	meta := testProviderConfig(server)
+
+	bucket, err := meta.client.Bucket.Create(ctx, &runscope.BucketCreateOpts{Name: "bucket", TeamUUID: server.TeamID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	test, err := meta.client.Test.Create(ctx, runscope.TestCreateOpts{BucketId: bucket.Key})
+	if err != nil {
+		t.Fatal(err)
+	}
+	scheduleOpts := &runscope.ScheduleCreateOpts{}
+	scheduleOpts.BucketId = bucket.Key
+	scheduleOpts.TestId = test.Id
+	scheduleOpts.EnvironmentId = test.DefaultEnvironmentId
+	scheduleOpts.Interval = "1h"
+	if _, err := meta.client.Schedule.Create(ctx, scheduleOpts); err != nil {
+		t.Fatal(err)
+	}
+	listSchedules := func() []*runscope.Schedule {
+		opts := &runscope.ScheduleListOpts{}
+		opts.BucketId = bucket.Key
+		opts.TestId = test.Id
+		schedules, err := meta.client.Schedule.List(ctx, opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return schedules
+	}
+
+	endsAt := time.Now().Truncate(time.Second).Add(2 * time.Second)
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"bucket_id": bucket.Key,
+		"test_id":   test.Id,
+		"ends_at":   endsAt.UTC().Format(time.RFC3339),
+	})
+	r := resourceRunscopeMaintenanceWindow()
+
+	diff, err := r.Diff(ctx, nil, config, meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	state, diags := r.Apply(ctx, nil, diff, meta)
+	if diags.HasError() {
+		t.Fatal(diags[0].Summary)
+	}
+	if state.Attributes["active"] != "true" || state.Attributes["schedules.#"] != "1" {
+		t.Fatalf("expected the schedule to be suspended, got %v", state.Attributes)
+	}
+	if s := listSchedules(); len(s) != 0 {
+		t.Fatalf("expected the schedule to be deleted, got %v", s)
+	}
+
+	time.Sleep(time.Until(endsAt))
+
+	diff, err = r.Diff(ctx, state, config, meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff == nil || diff.Attributes["active"] == nil || diff.Attributes["active"].New != "false" {
+		t.Fatalf("expected the window to be planned inactive, got %v", diff)
+	}
+	state, diags = r.Apply(ctx, state, diff, meta)
+	if diags.HasError() {
+		t.Fatal(diags[0].Summary)
+	}
+	if state.Attributes["active"] != "false" || state.Attributes["schedules.#"] != "0" {
+		t.Errorf("expected no suspended schedules left, got %v", state.Attributes)
+	}
+	if s := listSchedules(); len(s) != 1 || s[0].EnvironmentId != test.DefaultEnvironmentId || s[0].Interval != "1.0h" {
+		t.Errorf("expected the schedule to be restored, got %v", s)
+	}
+
+	diff, err = r.Diff(ctx, state, config, meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !diff.Empty() {
+		t.Errorf("expected no changes after the window ended, got %v", diff)
+	}
+}
+
+func TestMaintenanceWindow_partialSuspend(t *testing.T) {
+	ctx := context.Background()
+	server := runscopetest.NewServer()
+	defer server.Close()
+
+	// Deleting the schedules of the failing test fails until it is cleared.
+	var failing string
+	proxy := httputil.NewSingleHostReverseProxy(mustParseURL(t, server.URL))
+	front := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if failing != "" && r.Method == http.MethodDelete && strings.Contains(r.URL.Path, failing) {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		proxy.ServeHTTP(w, r)
+	}))
+	defer front.Close()
+	meta := testProviderConfig(server)
+	meta.client = runscope.NewClient(
+		runscope.WithEndpoint(front.URL),
+		runscope.WithToken(server.Token),
+		runscope.WithMaxRetries(0),
+	)
+
+	bucket, err := meta.client.Bucket.Create(ctx, &runscope.BucketCreateOpts{Name: "bucket", TeamUUID: server.TeamID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var tests []*runscope.Test
+	for i := 0; i < 2; i++ {
+		test, err := meta.client.Test.Create(ctx, runscope.TestCreateOpts{BucketId: bucket.Key})
+		if err != nil {
+			t.Fatal(err)
+		}
+		opts := &runscope.ScheduleCreateOpts{}
+		opts.BucketId = bucket.Key
+		opts.TestId = test.Id
+		opts.EnvironmentId = test.DefaultEnvironmentId
+		opts.Interval = "1h"
+		if _, err := meta.client.Schedule.Create(ctx, opts); err != nil {
+			t.Fatal(err)
+		}
+		tests = append(tests, test)
+	}
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"bucket_id": bucket.Key,
+		"ends_at":   "2030-01-01T00:00:00Z",
+	})
+	r := resourceRunscopeMaintenanceWindow()
+
+	failing = tests[1].Id
+	diff, err := r.Diff(ctx, nil, config, meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	state, diags := r.Apply(ctx, nil, diff, meta)
+	if !diags.HasError() {
+		t.Fatal("expected suspending to fail")
+	}
+	if state.Attributes["active"] != "false" || state.Attributes["schedules.#"] != "1" {
+		t.Fatalf("expected the window to be inactive with one suspended schedule, got %v", state.Attributes)
+	}
+
+	failing = ""
+	diff, err = r.Diff(ctx, state, config, meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	state, diags = r.Apply(ctx, state, diff, meta)
+	if diags.HasError() {
+		t.Fatal(diags[0].Summary)
+	}
+	if state.Attributes["active"] != "true" || state.Attributes["schedules.#"] != "2" {
+		t.Errorf("expected both schedules to be suspended once, got %v", state.Attributes)
+	}
+}
+
+func mustParseURL(t *testing.T, rawURL string) *url.URL {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return u
+}
+
+func TestMaintenanceWindow_diff(t *testing.T) {
+	now := time.Now().UTC()
+	past := now.Add(-time.Hour).Format(time.RFC3339)
+	future := now.Add(time.Hour).Format(time.RFC3339)
+
+	for _, tc := range []struct {
+		name     string
+		raw      map[string]interface{}
+		active   string
+		expected string
+	}{
+		{"active", map[string]interface{}{"starts_at": past, "ends_at": future}, "true", ""},
+		{"no start", map[string]interface{}{"ends_at": future}, "true", ""},
+		{"not started", map[string]interface{}{"starts_at": future, "ends_at": now.Add(2 * time.Hour).Format(time.RFC3339)}, "false", ""},
+		{"ended", map[string]interface{}{"ends_at": past}, "false", ""},
+		{"ends before start", map[string]interface{}{"starts_at": future, "ends_at": past}, "", "ends_at must be after starts_at"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.raw["bucket_id"] = "bucket"
+			diff, err := resourceRunscopeMaintenanceWindow().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(tc.raw), nil)
+			if tc.expected != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.expected) {
+					t.Fatalf("expected error %q, got %v", tc.expected, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if actual := diff.Attributes["active"].New; actual != tc.active {
+				t.Errorf("expected active %s, got %s", tc.active, actual)
+			}
+		})
+	}
+}
+
+func TestMaintenanceWindowActive(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	for _, tc := range []struct {
+		startsAt, endsAt time.Time
+		expected         bool
+	}{
+		{time.Time{}, now.Add(time.Minute), true},
+		{now.Add(-time.Minute), now.Add(time.Minute), true},
+		{now, now.Add(time.Minute), true},
+		{now.Add(time.Minute), now.Add(time.Hour), false},
+		{now.Add(-time.Hour), now, false},
+		{time.Time{}, now.Add(-time.Minute), false},
+	} {
+		if actual := maintenanceWindowActive(tc.startsAt, tc.endsAt, now); actual != tc.expected {
+			t.Errorf("expected %v for %s - %s, got %v", tc.expected, tc.startsAt, tc.endsAt, actual)
+		}
+	}
+}
@@ -2,6 +2,8 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -17,6 +19,20 @@ func resourceRunscopeSchedule() *schema.Resource {
 		ReadContext:   resourceScheduleRead,
 		UpdateContext: resourceScheduleUpdate,
 		DeleteContext: resourceScheduleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: func(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				parts := strings.Split(d.Id(), "/")
+				if len(parts) != 3 {
+					return nil, fmt.Errorf("schedule ID for import should be in format bucket_id/test_id/schedule_id")
+				}
+
+				d.Set("bucket_id", parts[0])
+				d.Set("test_id", parts[1])
+				d.SetId(parts[2])
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
 
 		Schema: map[string]*schema.Schema{
 			"bucket_id": {
@@ -43,6 +59,8 @@ func resourceRunscopeSchedule() *schema.Resource {
 				Optional: true,
 			},
 		},
+		Description: "Runs a test in an environment at a regular interval. " +
+			"Leave the schedule out of `runscope_maintenance_window` resources with `exclude_schedule_ids`.",
 	}
 }
 
@@ -61,12 +79,12 @@ func resourceScheduleCreate(ctx context.Context, d *schema.ResourceData, meta in
 
 	schedule, err := client.Schedule.Create(ctx, opts)
 	if err != nil {
-		if isNotFound(err) {
+		if errors.Is(err, runscope.ErrNotFound) {
 			d.SetId("")
 			return nil
 		}
 
-		return diag.Errorf("Couldn't create schedule: %s", err)
+		return apiErrorDiags(resourceRunscopeSchedule().Schema, "Couldn't create schedule", err)
 	}
 
 	d.SetId(schedule.Id)
@@ -82,7 +100,7 @@ func resourceScheduleRead(ctx context.Context, d *schema.ResourceData, meta inte
 
 	schedule, err := client.Schedule.Get(ctx, opts)
 	if err != nil {
-		if isNotFound(err) {
+		if errors.Is(err, runscope.ErrNotFound) {
 			d.SetId("")
 			return nil
 		}
@@ -91,7 +109,7 @@ func resourceScheduleRead(ctx context.Context, d *schema.ResourceData, meta inte
 	}
 
 	d.Set("environment_id", schedule.EnvironmentId)
-	d.Set("interval", strings.ReplaceAll(schedule.Interval, ".0", ""))
+	d.Set("interval", flattenScheduleInterval(schedule.Interval))
 	d.Set("note", schedule.Note)
 	return nil
 }
@@ -109,7 +127,7 @@ func resourceScheduleUpdate(ctx context.Context, d *schema.ResourceData, meta in
 
 	_, err := client.Schedule.Update(ctx, &opts)
 	if err != nil {
-		return diag.Errorf("Error updating schedule: %s", err)
+		return apiErrorDiags(resourceRunscopeSchedule().Schema, "Error updating schedule", err)
 	}
 
 	return resourceScheduleRead(ctx, d, meta)
@@ -9,7 +9,9 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope/runscopetest"
 )
 
 func TestAccSchedule_create_default(t *testing.T) {
@@ -54,6 +56,18 @@ func TestAccSchedule_create_custom(t *testing.T) {
 					resource.TestCheckResourceAttrSet("runscope_schedule.daily", "exported_at"),
 				),
 			},
+			{
+				ResourceName:      "runscope_schedule.daily",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["runscope_schedule.daily"]
+					if !ok {
+						return "", fmt.Errorf("not found runscope_schedule.daily")
+					}
+					return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["bucket_id"], rs.Primary.Attributes["test_id"], rs.Primary.ID), nil
+				},
+			},
 		},
 	})
 }
@@ -189,6 +203,93 @@ func testAccCheckScheduleExists(n string, sch *runscope.Schedule) resource.TestC
 	}
 }
 
+func TestSchedule_maintenanceWindow(t *testing.T) {
+	ctx := context.Background()
+	server := runscopetest.NewServer()
+	defer server.Close()
+	meta := testProviderConfig(server)
+
+	bucket, err := meta.client.Bucket.Create(ctx, &runscope.BucketCreateOpts{Name: "bucket", TeamUUID: server.TeamID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	test, err := meta.client.Test.Create(ctx, runscope.TestCreateOpts{BucketId: bucket.Key})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	raw := map[string]interface{}{
+		"bucket_id":      bucket.Key,
+		"test_id":        test.Id,
+		"environment_id": test.DefaultEnvironmentId,
+		"interval":       "1h",
+		"note":           "managed",
+	}
+	r := resourceRunscopeSchedule()
+	d := schema.TestResourceDataRaw(t, r.Schema, raw)
+	if diags := resourceScheduleCreate(ctx, d, meta); diags.HasError() {
+		t.Fatal(diags[0].Summary)
+	}
+	id := d.Id()
+
+	// A schedule set up outside of Terraform.
+	createOpts := &runscope.ScheduleCreateOpts{}
+	createOpts.BucketId = bucket.Key
+	createOpts.TestId = test.Id
+	createOpts.EnvironmentId = test.DefaultEnvironmentId
+	createOpts.Interval = "1d"
+	if _, err := meta.client.Schedule.Create(ctx, createOpts); err != nil {
+		t.Fatal(err)
+	}
+
+	window := schema.TestResourceDataRaw(t, resourceRunscopeMaintenanceWindow().Schema, map[string]interface{}{
+		"bucket_id":            bucket.Key,
+		"test_id":              test.Id,
+		"ends_at":              "2030-01-01T00:00:00Z",
+		"exclude_schedule_ids": []interface{}{id},
+	})
+	window.Set("active", true)
+	if diags := resourceMaintenanceWindowCreate(ctx, window, meta); diags.HasError() {
+		t.Fatal(diags[0].Summary)
+	}
+
+	suspended := window.Get("schedules").([]interface{})
+	if len(suspended) != 1 || suspended[0].(map[string]interface{})["interval"] != "1d" {
+		t.Fatalf("expected only the unmanaged schedule to be suspended, got %v", suspended)
+	}
+
+	// While the window is active, the managed schedule is refreshed and
+	// planned without changes.
+	if diags := resourceScheduleRead(ctx, d, meta); diags.HasError() {
+		t.Fatal(diags[0].Summary)
+	}
+	if d.Id() != id {
+		t.Fatalf("expected schedule %s to stay in state, got ID %q", id, d.Id())
+	}
+	diff, err := r.Diff(ctx, d.State(), terraform.NewResourceConfigRaw(raw), meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !diff.Empty() {
+		t.Errorf("expected no changes while the window is active, got %v", diff.Attributes)
+	}
+
+	if diags := resourceMaintenanceWindowDelete(ctx, window, meta); diags.HasError() {
+		t.Fatal(diags[0].Summary)
+	}
+
+	listOpts := &runscope.ScheduleListOpts{}
+	listOpts.BucketId = bucket.Key
+	listOpts.TestId = test.Id
+	schedules, err := meta.client.Schedule.List(ctx, listOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(schedules) != 2 {
+		t.Errorf("expected the managed and the restored schedule, got %d schedules", len(schedules))
+	}
+}
+
 const testAccScheduleDefaultConfig = `
 resource "runscope_bucket" "bucket" {
   name      = "%s"
@@ -2,7 +2,13 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope"
@@ -36,9 +42,102 @@ var stepComparisons = []string{
 	"is_null",
 }
 
+// stepMethods are the HTTP methods a request step can use. They are
+// accepted in any case and kept in upper case.
+var stepMethods = []string{
+	"GET",
+	"POST",
+	"PUT",
+	"PATCH",
+	"DELETE",
+	"HEAD",
+	"OPTIONS",
+}
+
+var stepAuthTypes = []string{
+	"basic",
+	"digest",
+	"oauth1",
+}
+
+// stepComparisonsWithoutValue are the comparisons that take no value to
+// compare with; all others need one.
+var stepComparisonsWithoutValue = []string{
+	"empty",
+	"not_empty",
+	"is_a_number",
+	"is_null",
+}
+
+var stepVariablePattern = regexp.MustCompile(`\{\{[^{}]+\}\}`)
+
+// validateStepURL checks that a step's URL is an absolute HTTP URL, or a
+// template starting with a {{variable}} such as {{base_url}}/users.
+// Variables elsewhere in the URL are allowed too. A leading variable is
+// taken to hold the scheme and host, and the rest of the URL must still
+// be valid after it.
+func validateStepURL(v interface{}, k string) (warnings []string, errs []error) {
+	value := v.(string)
+	template := value
+	if loc := stepVariablePattern.FindStringIndex(value); loc != nil && loc[0] == 0 {
+		template = "https://variable" + value[loc[1]:]
+	}
+
+	u, err := url.Parse(stepVariablePattern.ReplaceAllString(template, "variable"))
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, []error{fmt.Errorf("expected %s to be an absolute http or https URL or to start with a {{variable}}, got %s", k, value)}
+	}
+
+	return nil, nil
+}
+
+// customizeStepAssertionsDiff checks that the assertions of a step have a
+// value exactly when their comparison takes one. The error points at the
+// value of the first assertion that doesn't.
+func customizeStepAssertionsDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	for i, a := range d.Get("assertion").([]interface{}) {
+		if a == nil {
+			continue
+		}
+		assertion := a.(map[string]interface{})
+		comparison := assertion["comparison"].(string)
+		value := assertion["value"].(string)
+		key := fmt.Sprintf("assertion.%d.value", i)
+
+		if err := checkAssertionValue(comparison, value, d.NewValueKnown(key)); err != "" {
+			path := cty.GetAttrPath("assertion").IndexInt(i).GetAttr("value")
+			return path.NewErrorf("%s: %s", key, err)
+		}
+	}
+
+	return nil
+}
+
+// checkAssertionValue returns what is wrong with the value of an assertion
+// using comparison, if anything. Values not known until apply are taken to
+// be set.
+func checkAssertionValue(comparison, value string, known bool) string {
+	for _, c := range stepComparisonsWithoutValue {
+		if comparison == c {
+			if value != "" || !known {
+				return fmt.Sprintf("must not be set for comparison %s", comparison)
+			}
+			return ""
+		}
+	}
+
+	if value == "" && known {
+		return fmt.Sprintf("must be set for comparison %s", comparison)
+	}
+	return ""
+}
+
 func resourceStepDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*providerConfig).client
 
+	unlock := lockTestSteps(d, meta)
+	defer unlock()
+
 	opts := &runscope.StepDeleteOpts{}
 	expandStepGetOpts(d, &opts.StepGetRequestOpts)
 
@@ -49,7 +148,84 @@ func resourceStepDelete(ctx context.Context, d *schema.ResourceData, meta interf
 	return nil
 }
 
+// resourceStepImport returns an importer for steps of the given type. Steps
+// are addressed either as bucket_id/test_id/step_id or by their position in
+// the test as bucket_id/test_id#step_position. Only steps imported by
+// position are checked to be of the given type.
+func resourceStepImport(stepType string) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		parts := strings.Split(d.Id(), "/")
+
+		bucketId := parts[0]
+		d.Set("bucket_id", bucketId)
+
+		if len(parts) == 3 {
+			d.Set("test_id", parts[1])
+			d.SetId(parts[2])
+			return []*schema.ResourceData{d}, nil
+		}
+
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("step ID for import should be in format bucket_id/test_id/step_id " +
+				"or bucket_id/test_id#step_position")
+		}
+
+		posParts := strings.Split(parts[1], "#")
+		if len(posParts) != 2 {
+			return nil, fmt.Errorf("step ID for import should be in format bucket_id/test_id/step_id " +
+				"or bucket_id/test_id#step_position")
+		}
+
+		stepPos, err := strconv.Atoi(posParts[1])
+		if err != nil || stepPos < 1 {
+			return nil, fmt.Errorf("step_position should be a positive integer number")
+		}
+
+		testId := posParts[0]
+		d.Set("test_id", testId)
+
+		opts := runscope.TestGetOpts{
+			BucketId: bucketId,
+			Id:       testId,
+		}
+
+		client := meta.(*providerConfig).client
+
+		test, err := client.Test.Get(ctx, opts)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't read test: %s", err)
+		}
+
+		nSteps := len(test.Steps)
+		if nSteps < stepPos {
+			return nil, fmt.Errorf("test %s contains only %d steps", testId, nSteps)
+		}
+		step := test.Steps[stepPos-1]
+
+		// Unlike an ID, a position can easily point at the wrong step.
+		if step.StepType != stepType {
+			return nil, fmt.Errorf("step %d of test %s is a %s step, not a %s step as imported into", stepPos, testId, step.StepType, stepType)
+		}
+
+		d.SetId(step.Id)
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
 func expandStepUriOpts(d *schema.ResourceData, opts *runscope.StepUriOpts) {
 	opts.BucketId = d.Get("bucket_id").(string)
 	opts.TestId = d.Get("test_id").(string)
 }
+
+// lockTestSteps blocks other step creations and deletions in the same test
+// until the returned function is called.
+func lockTestSteps(d *schema.ResourceData, meta interface{}) func() {
+	locks := meta.(*providerConfig).stepLocks
+	key := d.Get("bucket_id").(string) + "/" + d.Get("test_id").(string)
+
+	locks.Lock(key)
+	return func() {
+		locks.Unlock(key)
+	}
+}
@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope"
+)
+
+func resourceRunscopeStepCondition() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceStepConditionCreate,
+		ReadContext:   resourceStepConditionRead,
+		UpdateContext: resourceStepConditionUpdate,
+		DeleteContext: resourceStepDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceStepImport("condition"),
+		},
+		Schema: map[string]*schema.Schema{
+			"bucket_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The bucket of the test this step belong to.",
+			},
+			"test_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the test this step belongs to.",
+			},
+			"left_value": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The value to compare, e.g. `{{feature_enabled}}`.",
+			},
+			"comparison": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(stepComparisons, false),
+				Description:  "The comparison type, eg `equal` or `is_null`.",
+			},
+			"right_value": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The value to compare left_value against.",
+			},
+		},
+		Description: "A step that skips the remainder of the test unless its condition holds.",
+	}
+}
+
+func resourceStepConditionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerConfig).client
+
+	var opts runscope.StepCreateConditionOpts
+	expandStepUriOpts(d, &opts.StepUriOpts)
+	expandStepConditionOpts(d, &opts.StepConditionOpts)
+
+	unlock := lockTestSteps(d, meta)
+	step, err := client.Step.CreateCondition(ctx, &opts)
+	unlock()
+	if err != nil {
+		return apiErrorDiags(resourceRunscopeStepCondition().Schema, "Couldn't create step", err)
+	}
+
+	d.SetId(step.ID)
+
+	return resourceStepConditionRead(ctx, d, meta)
+}
+
+func resourceStepConditionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerConfig).client
+
+	opts := &runscope.StepGetRequestOpts{}
+	expandStepGetOpts(d, opts)
+
+	step, err := client.Step.GetCondition(ctx, opts)
+	if err != nil {
+		if errors.Is(err, runscope.ErrNotFound) {
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Couldn't read step: %s", err)
+	}
+
+	d.Set("left_value", step.LeftValue)
+	d.Set("comparison", step.Comparison)
+	d.Set("right_value", step.RightValue)
+
+	return nil
+}
+
+func resourceStepConditionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerConfig).client
+
+	opts := &runscope.StepUpdateConditionOpts{}
+	expandStepGetOpts(d, &opts.StepGetRequestOpts)
+	expandStepConditionOpts(d, &opts.StepConditionOpts)
+
+	_, err := client.Step.UpdateCondition(ctx, opts)
+	if err != nil {
+		return apiErrorDiags(resourceRunscopeStepCondition().Schema, "Couldn't update step", err)
+	}
+
+	return resourceStepConditionRead(ctx, d, meta)
+}
+
+func expandStepConditionOpts(d *schema.ResourceData, opts *runscope.StepConditionOpts) {
+	opts.LeftValue = d.Get("left_value").(string)
+	opts.Comparison = d.Get("comparison").(string)
+	opts.RightValue = d.Get("right_value").(string)
+}
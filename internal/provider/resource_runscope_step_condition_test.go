@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope"
+)
+
+func TestAccStepCondition_update(t *testing.T) {
+	teamId := os.Getenv("RUNSCOPE_TEAM_ID")
+	bucketName := testAccRandomBucketName()
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckStepConditionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccStepConditionConfig, bucketName, teamId, "equal", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStepConditionExists("runscope_step_condition.condition"),
+					resource.TestCheckResourceAttr("runscope_step_condition.condition", "left_value", "{{feature_enabled}}"),
+					resource.TestCheckResourceAttr("runscope_step_condition.condition", "comparison", "equal"),
+					resource.TestCheckResourceAttr("runscope_step_condition.condition", "right_value", "true"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccStepConditionConfig, bucketName, teamId, "not_equal", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStepConditionExists("runscope_step_condition.condition"),
+					resource.TestCheckResourceAttr("runscope_step_condition.condition", "comparison", "not_equal"),
+					resource.TestCheckResourceAttr("runscope_step_condition.condition", "right_value", "false"),
+				),
+			},
+			{
+				ResourceName:      "runscope_step_condition.condition",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["runscope_step_condition.condition"]
+					if !ok {
+						return "", fmt.Errorf("not found runscope_step_condition.condition")
+					}
+					return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["bucket_id"], rs.Primary.Attributes["test_id"], rs.Primary.ID), nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccStepCondition_invalid_comparison(t *testing.T) {
+	teamId := os.Getenv("RUNSCOPE_TEAM_ID")
+	bucketName := testAccRandomBucketName()
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckStepConditionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      fmt.Sprintf(testAccStepConditionConfig, bucketName, teamId, "invalid_comparison", "true"),
+				ExpectError: regexp.MustCompile("expected comparison to be one of"),
+			},
+		},
+	})
+}
+
+func testAccCheckStepConditionDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*providerConfig).client
+	ctx := context.Background()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "runscope_step_condition" {
+			continue
+		}
+
+		opts := &runscope.StepGetRequestOpts{}
+		opts.Id = rs.Primary.ID
+		opts.BucketId = rs.Primary.Attributes["bucket_id"]
+		opts.TestId = rs.Primary.Attributes["test_id"]
+
+		if _, err := client.Step.GetCondition(ctx, opts); err == nil {
+			return fmt.Errorf("Record %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckStepConditionExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		ctx := context.Background()
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+
+		client := testAccProvider.Meta().(*providerConfig).client
+
+		opts := &runscope.StepGetRequestOpts{}
+		opts.Id = rs.Primary.ID
+		opts.TestId = rs.Primary.Attributes["test_id"]
+		opts.BucketId = rs.Primary.Attributes["bucket_id"]
+
+		step, err := client.Step.GetCondition(ctx, opts)
+		if err != nil {
+			return err
+		}
+
+		if step.ID != rs.Primary.ID {
+			return fmt.Errorf("Record not found")
+		}
+
+		return nil
+	}
+}
+
+const testAccStepConditionConfig = `
+resource "runscope_bucket" "bucket" {
+  name      = "%s"
+  team_uuid = "%s"
+}
+
+resource "runscope_test" "test" {
+  bucket_id   = runscope_bucket.bucket.id
+  name        = "runscope test"
+  description = "This is a test test..."
+}
+
+resource "runscope_step_condition" "condition" {
+  bucket_id   = runscope_bucket.bucket.id
+  test_id     = runscope_test.test.id
+  left_value  = "{{feature_enabled}}"
+  comparison  = "%s"
+  right_value = "%s"
+}
+`
@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope"
+)
+
+func resourceRunscopeStepIncomingRequest() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceStepIncomingRequestCreate,
+		ReadContext:   resourceStepIncomingRequestRead,
+		UpdateContext: resourceStepIncomingRequestUpdate,
+		DeleteContext: resourceStepDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceStepImport("incoming_request"),
+		},
+		CustomizeDiff: customizeStepAssertionsDiff,
+		Schema: map[string]*schema.Schema{
+			"bucket_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The bucket of the test this step belong to.",
+			},
+			"test_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the test this step belongs to.",
+			},
+			"note": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A note for this step.",
+			},
+			"capture_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL the step waits for a request to be sent to.",
+			},
+			"variable": {
+				Type: schema.TypeSet,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the extracted variable, which can be used to reference the value elsewhere.",
+						},
+						"property": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The property to extract.",
+						},
+						"source": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(stepSources, false),
+							Description:  "The source of the property, e.g. `response_json`.",
+						},
+					},
+				},
+				Optional:    true,
+				Description: "Variables to extract from the captured request.",
+			},
+			"assertion": {
+				Type: schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(stepSources, false),
+							Description:  "The source of the property to assert.",
+						},
+						"property": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The property to assert on.",
+						},
+						"comparison": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(stepComparisons, false),
+							Description:  "The comparison type, eg `equal` or `has_key`.",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The value to assert the source.property has.",
+						},
+					},
+				},
+				Optional:    true,
+				Description: "Assertions the captured request must pass.",
+			},
+		},
+		Description: "A step that waits for a request to be sent to its generated capture URL, e.g. by a webhook.",
+	}
+}
+
+func resourceStepIncomingRequestCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerConfig).client
+
+	var opts runscope.StepCreateIncomingRequestOpts
+	expandStepUriOpts(d, &opts.StepUriOpts)
+	expandStepIncomingRequestOpts(d, &opts.StepIncomingRequestOpts)
+
+	unlock := lockTestSteps(d, meta)
+	step, err := client.Step.CreateIncomingRequest(ctx, &opts)
+	unlock()
+	if err != nil {
+		return apiErrorDiags(resourceRunscopeStepIncomingRequest().Schema, "Couldn't create step", err)
+	}
+
+	d.SetId(step.ID)
+
+	return resourceStepIncomingRequestRead(ctx, d, meta)
+}
+
+func resourceStepIncomingRequestRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerConfig).client
+
+	opts := &runscope.StepGetRequestOpts{}
+	expandStepGetOpts(d, opts)
+
+	step, err := client.Step.GetIncomingRequest(ctx, opts)
+	if err != nil {
+		if errors.Is(err, runscope.ErrNotFound) {
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Couldn't read step: %s", err)
+	}
+
+	d.Set("note", step.Note)
+	d.Set("capture_url", step.CaptureURL)
+	d.Set("variable", flattenStepVariables(step.Variables))
+	d.Set("assertion", flattenStepAssertions(step.Assertions))
+
+	return nil
+}
+
+func resourceStepIncomingRequestUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerConfig).client
+
+	opts := &runscope.StepUpdateIncomingRequestOpts{}
+	expandStepGetOpts(d, &opts.StepGetRequestOpts)
+	expandStepIncomingRequestOpts(d, &opts.StepIncomingRequestOpts)
+
+	_, err := client.Step.UpdateIncomingRequest(ctx, opts)
+	if err != nil {
+		return apiErrorDiags(resourceRunscopeStepIncomingRequest().Schema, "Couldn't update step", err)
+	}
+
+	return resourceStepIncomingRequestRead(ctx, d, meta)
+}
+
+func expandStepIncomingRequestOpts(d *schema.ResourceData, opts *runscope.StepIncomingRequestOpts) {
+	opts.Note = d.Get("note").(string)
+	if v, ok := d.GetOk("variable"); ok {
+		opts.Variables = expandStepVariables(v.(*schema.Set).List())
+	}
+	if v, ok := d.GetOk("assertion"); ok {
+		opts.Assertions = expandStepAssertions(v.([]interface{}))
+	}
+}
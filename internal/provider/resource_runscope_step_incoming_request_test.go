@@ -0,0 +1,195 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope"
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope/runscopetest"
+)
+
+func TestAccStepIncomingRequest_update(t *testing.T) {
+	teamId := os.Getenv("RUNSCOPE_TEAM_ID")
+	bucketName := testAccRandomBucketName()
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckStepIncomingRequestDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccStepIncomingRequestConfig, bucketName, teamId, "Wait for the webhook"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStepIncomingRequestExists("runscope_step_incoming_request.callback"),
+					resource.TestCheckResourceAttr("runscope_step_incoming_request.callback", "note", "Wait for the webhook"),
+					resource.TestCheckResourceAttrSet("runscope_step_incoming_request.callback", "capture_url"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccStepIncomingRequestConfig, bucketName, teamId, "Wait for the callback"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStepIncomingRequestExists("runscope_step_incoming_request.callback"),
+					resource.TestCheckResourceAttr("runscope_step_incoming_request.callback", "note", "Wait for the callback"),
+					resource.TestCheckResourceAttrSet("runscope_step_incoming_request.callback", "capture_url"),
+				),
+			},
+			{
+				ResourceName:      "runscope_step_incoming_request.callback",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["runscope_step_incoming_request.callback"]
+					if !ok {
+						return "", fmt.Errorf("not found runscope_step_incoming_request.callback")
+					}
+					return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["bucket_id"], rs.Primary.Attributes["test_id"], rs.Primary.ID), nil
+				},
+			},
+		},
+	})
+}
+
+func TestStepIncomingRequest_assertions(t *testing.T) {
+	ctx := context.Background()
+	server := runscopetest.NewServer()
+	defer server.Close()
+	meta := testProviderConfig(server)
+
+	bucket, err := meta.client.Bucket.Create(ctx, &runscope.BucketCreateOpts{Name: "bucket", TeamUUID: server.TeamID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	test, err := meta.client.Test.Create(ctx, runscope.TestCreateOpts{BucketId: bucket.Key})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	raw := map[string]interface{}{
+		"bucket_id": bucket.Key,
+		"test_id":   test.Id,
+		"variable": []interface{}{map[string]interface{}{
+			"name":     "event",
+			"property": "type",
+			"source":   "response_json",
+		}},
+		"assertion": []interface{}{map[string]interface{}{
+			"source":     "response_json",
+			"property":   "type",
+			"comparison": "equal",
+			"value":      "subscription.created",
+		}},
+	}
+	d := schema.TestResourceDataRaw(t, resourceRunscopeStepIncomingRequest().Schema, raw)
+	if diags := resourceStepIncomingRequestCreate(ctx, d, meta); diags.HasError() {
+		t.Fatal(diags[0].Summary)
+	}
+
+	opts := &runscope.StepGetRequestOpts{Id: d.Id()}
+	opts.BucketId = bucket.Key
+	opts.TestId = test.Id
+	step, err := meta.client.Step.GetIncomingRequest(ctx, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(step.Assertions) != 1 || step.Assertions[0].Value != "subscription.created" {
+		t.Errorf("expected the assertion to be created, got %+v", step.Assertions)
+	}
+	if len(step.Variables) != 1 || step.Variables[0].Name != "event" {
+		t.Errorf("expected the variable to be created, got %+v", step.Variables)
+	}
+	if n := d.Get("assertion.#").(int); n != 1 {
+		t.Errorf("expected 1 assertion to be read, got %d", n)
+	}
+	if n := d.Get("variable.#").(int); n != 1 {
+		t.Errorf("expected 1 variable to be read, got %d", n)
+	}
+
+	raw["assertion"] = []interface{}{map[string]interface{}{
+		"source":     "response_json",
+		"property":   "type",
+		"comparison": "equal",
+	}}
+	_, err = resourceRunscopeStepIncomingRequest().Diff(ctx, nil, terraform.NewResourceConfigRaw(raw), nil)
+	if expected := "assertion.0.value: must be set for comparison equal"; err == nil || !strings.Contains(err.Error(), expected) {
+		t.Errorf("expected error %q, got %v", expected, err)
+	}
+}
+
+func testAccCheckStepIncomingRequestDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*providerConfig).client
+	ctx := context.Background()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "runscope_step_incoming_request" {
+			continue
+		}
+
+		opts := &runscope.StepGetRequestOpts{}
+		opts.Id = rs.Primary.ID
+		opts.BucketId = rs.Primary.Attributes["bucket_id"]
+		opts.TestId = rs.Primary.Attributes["test_id"]
+
+		if _, err := client.Step.GetIncomingRequest(ctx, opts); err == nil {
+			return fmt.Errorf("Record %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckStepIncomingRequestExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		ctx := context.Background()
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+
+		client := testAccProvider.Meta().(*providerConfig).client
+
+		opts := &runscope.StepGetRequestOpts{}
+		opts.Id = rs.Primary.ID
+		opts.TestId = rs.Primary.Attributes["test_id"]
+		opts.BucketId = rs.Primary.Attributes["bucket_id"]
+
+		step, err := client.Step.GetIncomingRequest(ctx, opts)
+		if err != nil {
+			return err
+		}
+
+		if step.ID != rs.Primary.ID {
+			return fmt.Errorf("Record not found")
+		}
+
+		return nil
+	}
+}
+
+const testAccStepIncomingRequestConfig = `
+resource "runscope_bucket" "bucket" {
+  name      = "%s"
+  team_uuid = "%s"
+}
+
+resource "runscope_test" "test" {
+  bucket_id   = runscope_bucket.bucket.id
+  name        = "runscope test"
+  description = "This is a test test..."
+}
+
+resource "runscope_step_incoming_request" "callback" {
+  bucket_id = runscope_bucket.bucket.id
+  test_id   = runscope_test.test.id
+  note      = "%s"
+}
+`
@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope"
+)
+
+func resourceRunscopeStepPause() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceStepPauseCreate,
+		ReadContext:   resourceStepPauseRead,
+		UpdateContext: resourceStepPauseUpdate,
+		DeleteContext: resourceStepDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceStepImport("pause"),
+		},
+		Schema: map[string]*schema.Schema{
+			"bucket_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The bucket of the test this step belong to.",
+			},
+			"test_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the test this step belongs to.",
+			},
+			"duration": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The number of seconds to pause before the next step.",
+			},
+		},
+		Description: "A step pausing a test for a number of seconds.",
+	}
+}
+
+func resourceStepPauseCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerConfig).client
+
+	var opts runscope.StepCreatePauseOpts
+	expandStepUriOpts(d, &opts.StepUriOpts)
+	expandStepPauseOpts(d, &opts.StepPauseOpts)
+
+	unlock := lockTestSteps(d, meta)
+	step, err := client.Step.CreatePause(ctx, &opts)
+	unlock()
+	if err != nil {
+		return apiErrorDiags(resourceRunscopeStepPause().Schema, "Couldn't create step", err)
+	}
+
+	d.SetId(step.ID)
+
+	return resourceStepPauseRead(ctx, d, meta)
+}
+
+func resourceStepPauseRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerConfig).client
+
+	opts := &runscope.StepGetRequestOpts{}
+	expandStepGetOpts(d, opts)
+
+	step, err := client.Step.GetPause(ctx, opts)
+	if err != nil {
+		if errors.Is(err, runscope.ErrNotFound) {
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Couldn't read step: %s", err)
+	}
+
+	d.Set("duration", step.Duration)
+
+	return nil
+}
+
+func resourceStepPauseUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerConfig).client
+
+	opts := &runscope.StepUpdatePauseOpts{}
+	expandStepGetOpts(d, &opts.StepGetRequestOpts)
+	expandStepPauseOpts(d, &opts.StepPauseOpts)
+
+	_, err := client.Step.UpdatePause(ctx, opts)
+	if err != nil {
+		return apiErrorDiags(resourceRunscopeStepPause().Schema, "Couldn't update step", err)
+	}
+
+	return resourceStepPauseRead(ctx, d, meta)
+}
+
+func expandStepPauseOpts(d *schema.ResourceData, opts *runscope.StepPauseOpts) {
+	opts.Duration = d.Get("duration").(int)
+}
@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope"
+)
+
+func TestAccStepPause_update(t *testing.T) {
+	teamId := os.Getenv("RUNSCOPE_TEAM_ID")
+	bucketName := testAccRandomBucketName()
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckStepPauseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccStepPauseConfig, bucketName, teamId, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStepPauseExists("runscope_step_pause.pause"),
+					resource.TestCheckResourceAttr("runscope_step_pause.pause", "duration", "5"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccStepPauseConfig, bucketName, teamId, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStepPauseExists("runscope_step_pause.pause"),
+					resource.TestCheckResourceAttr("runscope_step_pause.pause", "duration", "10"),
+				),
+			},
+			{
+				ResourceName:      "runscope_step_pause.pause",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["runscope_step_pause.pause"]
+					if !ok {
+						return "", fmt.Errorf("not found runscope_step_pause.pause")
+					}
+					return fmt.Sprintf("%s/%s#%d", rs.Primary.Attributes["bucket_id"], rs.Primary.Attributes["test_id"], 2), nil
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckStepPauseDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*providerConfig).client
+	ctx := context.Background()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "runscope_step_pause" {
+			continue
+		}
+
+		opts := &runscope.StepGetRequestOpts{}
+		opts.Id = rs.Primary.ID
+		opts.BucketId = rs.Primary.Attributes["bucket_id"]
+		opts.TestId = rs.Primary.Attributes["test_id"]
+
+		if _, err := client.Step.GetPause(ctx, opts); err == nil {
+			return fmt.Errorf("Record %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckStepPauseExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		ctx := context.Background()
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+
+		client := testAccProvider.Meta().(*providerConfig).client
+
+		opts := &runscope.StepGetRequestOpts{}
+		opts.Id = rs.Primary.ID
+		opts.TestId = rs.Primary.Attributes["test_id"]
+		opts.BucketId = rs.Primary.Attributes["bucket_id"]
+
+		step, err := client.Step.GetPause(ctx, opts)
+		if err != nil {
+			return err
+		}
+
+		if step.ID != rs.Primary.ID {
+			return fmt.Errorf("Record not found")
+		}
+
+		return nil
+	}
+}
+
+const testAccStepPauseConfig = `
+resource "runscope_bucket" "bucket" {
+  name      = "%s"
+  team_uuid = "%s"
+}
+
+resource "runscope_test" "test" {
+  bucket_id   = runscope_bucket.bucket.id
+  name        = "runscope test"
+  description = "This is a test test..."
+}
+
+resource "runscope_step_request" "write" {
+  bucket_id = runscope_bucket.bucket.id
+  test_id   = runscope_test.test.id
+  method    = "POST"
+  url       = "https://example.org"
+}
+
+resource "runscope_step_pause" "pause" {
+  bucket_id  = runscope_bucket.bucket.id
+  test_id    = runscope_test.test.id
+  duration   = %d
+  depends_on = [runscope_step_request.write]
+}
+`
@@ -2,11 +2,9 @@ package provider
 
 import (
 	"context"
-	"fmt"
-	"strconv"
+	"errors"
 	"strings"
 
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope"
@@ -21,59 +19,9 @@ func resourceRunscopeStepRequest() *schema.Resource {
 		UpdateContext: resourceStepRequestUpdate,
 		DeleteContext: resourceStepDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				parts := strings.Split(d.Id(), "/")
-
-				bucketId := parts[0]
-				d.Set("bucket_id", bucketId)
-
-				if len(parts) == 3 {
-					d.Set("test_id", parts[1])
-					d.SetId(parts[2])
-					return []*schema.ResourceData{d}, nil
-				}
-
-				if len(parts) != 2 {
-					return nil, fmt.Errorf("step ID for import should be in format bucket_id/test_id/step_id " +
-						"or bucket_id/test_id#step_position")
-				}
-
-				parts = strings.Split(parts[1], "#")
-				if len(parts) != 2 {
-					return nil, fmt.Errorf("step ID for import should be in format bucket_id/test_id/step_id " +
-						"or bucket_id/test_id#step_position")
-				}
-
-				stepPos, err := strconv.Atoi(parts[1])
-				if err != nil || stepPos < 1 {
-					return nil, fmt.Errorf("step_position should be a positive integer number")
-				}
-
-				testId := parts[0]
-				d.Set("test_id", testId)
-
-				opts := runscope.TestGetOpts{
-					BucketId: bucketId,
-					Id:       testId,
-				}
-
-				client := meta.(*providerConfig).client
-
-				test, err := client.Test.Get(ctx, opts)
-				if err != nil {
-					return nil, fmt.Errorf("couldn't read test: %s", err)
-				}
-
-				nSteps := len(test.Steps)
-				if nSteps < stepPos {
-					return nil, fmt.Errorf("test %s contains only %d steps", testId, nSteps)
-				}
-
-				d.SetId(test.Steps[stepPos-1].Id)
-
-				return []*schema.ResourceData{d}, nil
-			},
+			StateContext: resourceStepImport("request"),
 		},
+		CustomizeDiff: customizeStepAssertionsDiff,
 		Schema: map[string]*schema.Schema{
 			"bucket_id": {
 				Type:     schema.TypeString,
@@ -86,12 +34,17 @@ func resourceRunscopeStepRequest() *schema.Resource {
 				ForceNew: true,
 			},
 			"method": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(stepMethods, true),
+				StateFunc: func(v interface{}) string {
+					return strings.ToUpper(v.(string))
+				},
 			},
 			"url": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateStepURL,
 			},
 			"variable": {
 				Type: schema.TypeSet,
@@ -143,6 +96,7 @@ func resourceRunscopeStepRequest() *schema.Resource {
 			"header": {
 				Type:     schema.TypeSet,
 				Optional: true,
+				Set:      hashHeader,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"header": {
@@ -166,19 +120,24 @@ func resourceRunscopeStepRequest() *schema.Resource {
 							Required: true,
 						},
 						"auth_type": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(stepAuthTypes, false),
 						},
 						"password": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:        schema.TypeString,
+							Required:    true,
+							Sensitive:   true,
+							Description: "The password. Stored in the Terraform state like any other argument, as write-only arguments aren't supported; keep the state somewhere safe.",
 						},
 					},
 				},
 			},
 			"body": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressEquivalentBody,
+				Description:      "The body of the request. JSON, XML and form bodies are compared by their content when the Content-Type header says so.",
 			},
 			"form_parameter": {
 				Type:     schema.TypeSet,
@@ -225,9 +184,11 @@ func resourceStepRequestCreate(ctx context.Context, d *schema.ResourceData, meta
 	expandStepUriOpts(d, &opts.StepUriOpts)
 	expandStepRequestOpts(d, &opts.StepRequestOpts)
 
+	unlock := lockTestSteps(d, meta)
 	step, err := client.Step.CreateRequest(ctx, opts)
+	unlock()
 	if err != nil {
-		return diag.Errorf("Couldn't create step: %s", err)
+		return apiErrorDiags(resourceRunscopeStepRequest().Schema, "Couldn't create step", err)
 	}
 
 	d.SetId(step.ID)
@@ -245,7 +206,7 @@ func resourceStepRequestRead(ctx context.Context, d *schema.ResourceData, meta i
 
 	step, err := client.Step.GetRequest(ctx, opts)
 	if err != nil {
-		if isNotFound(err) {
+		if errors.Is(err, runscope.ErrNotFound) {
 			d.SetId("")
 			return nil
 		}
@@ -253,13 +214,17 @@ func resourceStepRequestRead(ctx context.Context, d *schema.ResourceData, meta i
 		return diag.Errorf("Couldn't read step: %s", err)
 	}
 
-	d.Set("method", step.Method)
+	d.Set("method", strings.ToUpper(step.Method))
 	d.Set("url", step.StepURL)
 	d.Set("variable", flattenStepVariables(step.Variables))
 	d.Set("assertion", flattenStepAssertions(step.Assertions))
 	d.Set("header", flattenStepHeaders(step.Headers))
 	if !step.Auth.Empty() {
-		d.Set("auth", flattenStepAuth(step.Auth))
+		auth := step.Auth
+		if v, ok := d.GetOk("auth"); ok {
+			auth.Password = keepRedacted(expandStepAuth(v.(*schema.Set).List()).Password, auth.Password)
+		}
+		d.Set("auth", flattenStepAuth(auth))
 	}
 	d.Set("body", step.Body)
 	d.Set("form_parameter", flattenFormParameters(step.Form))
@@ -278,10 +243,9 @@ func resourceStepRequestUpdate(ctx context.Context, d *schema.ResourceData, meta
 	expandStepGetOpts(d, &opts.StepGetRequestOpts)
 	expandStepRequestOpts(d, &opts.StepRequestOpts)
 
-	tflog.Info(ctx, "CALLING UPDATE REQUEST")
 	_, err := client.Step.UpdateRequest(ctx, opts)
 	if err != nil {
-		return diag.Errorf("Couldn't create step: %s", err)
+		return apiErrorDiags(resourceRunscopeStepRequest().Schema, "Couldn't update step", err)
 	}
 
 	return resourceStepRequestRead(ctx, d, meta)
@@ -294,7 +258,7 @@ func expandStepGetOpts(d *schema.ResourceData, opts *runscope.StepGetRequestOpts
 
 func expandStepRequestOpts(d *schema.ResourceData, opts *runscope.StepRequestOpts) {
 	if v, ok := d.GetOk("method"); ok {
-		opts.Method = v.(string)
+		opts.Method = strings.ToUpper(v.(string))
 	}
 	if v, ok := d.GetOk("url"); ok {
 		opts.StepURL = v.(string)
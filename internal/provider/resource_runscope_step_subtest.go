@@ -16,6 +16,9 @@ func resourceRunscopeStepSubtest() *schema.Resource {
 		ReadContext:   resourceStepSubtestRead,
 		UpdateContext: resourceStepSubtestUpdate,
 		DeleteContext: resourceStepDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceStepImport("subtest"),
+		},
 		Schema: map[string]*schema.Schema{
 			"bucket_id": {
 				Type:        schema.TypeString,
@@ -117,9 +120,11 @@ func resourceStepSubtestCreate(ctx context.Context, d *schema.ResourceData, meta
 	expandStepUriOpts(d, &opts.StepUriOpts)
 	expandStepSubtestOpts(d, &opts.StepSubtestOpts)
 
+	unlock := lockTestSteps(d, meta)
 	step, err := client.Step.CreateSubtest(ctx, &opts)
+	unlock()
 	if err != nil {
-		if isNotFound(err) {
+		if errors.Is(err, runscope.ErrNotFound) {
 			d.SetId("")
 			return nil
 		}
@@ -145,7 +150,7 @@ func resourceStepSubtestRead(ctx context.Context, d *schema.ResourceData, meta i
 
 	step, err := client.Step.GetSubtest(ctx, opts)
 	if err != nil {
-		if isNotFound(err) {
+		if errors.Is(err, runscope.ErrNotFound) {
 			d.SetId("")
 			return nil
 		}
@@ -174,7 +179,7 @@ func resourceStepSubtestUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 	_, err := client.Step.UpdateSubtest(ctx, opts)
 	if err != nil {
-		return diag.Errorf("Couldn't create step: %s", err)
+		return apiErrorDiags(resourceRunscopeStepSubtest().Schema, "Couldn't create step", err)
 	}
 
 	return resourceStepSubtestRead(ctx, d, meta)
@@ -5,9 +5,15 @@ import (
 	"fmt"
 	"os"
 	"regexp"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope"
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope/runscopetest"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -314,7 +320,13 @@ func TestAccStep_valid_assertion_comparison(t *testing.T) {
 		Steps: func() []resource.TestStep {
 			steps := make([]resource.TestStep, len(stepComparisons))
 			for i, source := range stepComparisons {
-				steps[i].Config = fmt.Sprintf(testAccStepAssertionComparisonsConfig, bucketName, teamId, source)
+				value := "1"
+				for _, c := range stepComparisonsWithoutValue {
+					if source == c {
+						value = ""
+					}
+				}
+				steps[i].Config = fmt.Sprintf(testAccStepAssertionComparisonsConfig, bucketName, teamId, source, value)
 				steps[i].Check = resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("runscope_step.step", "assertion.0.comparison", source),
 				)
@@ -333,13 +345,308 @@ func TestAccStep_invalid_assertion_comparison(t *testing.T) {
 		CheckDestroy:      testAccCheckStepDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config:      fmt.Sprintf(testAccStepAssertionComparisonsConfig, bucketName, teamId, "invalid_compatison"),
+				Config:      fmt.Sprintf(testAccStepAssertionComparisonsConfig, bucketName, teamId, "invalid_compatison", "1"),
 				ExpectError: regexp.MustCompile("expected assertion.0.comparison to be one of"),
 			},
 		},
 	})
 }
 
+func TestStepRequest_concurrent_create(t *testing.T) {
+	ctx := context.Background()
+	server := runscopetest.NewServer()
+	defer server.Close()
+	meta := testProviderConfig(server)
+
+	bucket, err := meta.client.Bucket.Create(ctx, &runscope.BucketCreateOpts{Name: "bucket", TeamUUID: server.TeamID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	test, err := meta.client.Test.Create(ctx, runscope.TestCreateOpts{BucketId: bucket.Key})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Hold every creation open for a moment, so that steps created in
+	// parallel all show up in each other's responses.
+	server.StepCreated = func(_, _, _ string) {
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	const n = 5
+	steps := make([]*schema.ResourceData, n)
+	var wg sync.WaitGroup
+	for i := range steps {
+		steps[i] = schema.TestResourceDataRaw(t, resourceRunscopeStepRequest().Schema, map[string]interface{}{
+			"bucket_id": bucket.Key,
+			"test_id":   test.Id,
+			"method":    "GET",
+			"url":       fmt.Sprintf("https://%d.example.com", i),
+		})
+
+		wg.Add(1)
+		go func(d *schema.ResourceData) {
+			defer wg.Done()
+			if diags := resourceStepRequestCreate(ctx, d, meta); diags.HasError() {
+				t.Error(diags[0].Summary)
+			}
+		}(steps[i])
+	}
+	wg.Wait()
+
+	ids := map[string]bool{}
+	for i, d := range steps {
+		expectedURL := fmt.Sprintf("https://%d.example.com", i)
+		if url := d.Get("url").(string); url != expectedURL {
+			t.Errorf("step %d got the ID of another step: expected url %s, got %s", i, expectedURL, url)
+		}
+		ids[d.Id()] = true
+	}
+	if len(ids) != n {
+		t.Errorf("expected %d distinct step IDs, got %d", n, len(ids))
+	}
+}
+
+func TestStepRequest_redacted_password(t *testing.T) {
+	ctx := context.Background()
+	server := runscopetest.NewServer()
+	defer server.Close()
+	server.RedactSecrets = true
+	meta := testProviderConfig(server)
+
+	bucket, err := meta.client.Bucket.Create(ctx, &runscope.BucketCreateOpts{Name: "bucket", TeamUUID: server.TeamID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	test, err := meta.client.Test.Create(ctx, runscope.TestCreateOpts{BucketId: bucket.Key})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceRunscopeStepRequest().Schema, map[string]interface{}{
+		"bucket_id": bucket.Key,
+		"test_id":   test.Id,
+		"method":    "GET",
+		"url":       "https://example.com",
+		"auth": []interface{}{map[string]interface{}{
+			"username":  "user",
+			"password":  "secret",
+			"auth_type": "basic",
+		}},
+	})
+	if diags := resourceStepRequestCreate(ctx, d, meta); diags.HasError() {
+		t.Fatal(diags[0].Summary)
+	}
+	if diags := resourceStepRequestRead(ctx, d, meta); diags.HasError() {
+		t.Fatal(diags[0].Summary)
+	}
+
+	auth := expandStepAuth(d.Get("auth").(*schema.Set).List())
+	if auth.Password != "secret" {
+		t.Errorf("expected the configured password to be kept, got %s", auth.Password)
+	}
+	if auth.Username != "user" {
+		t.Errorf("expected username user, got %s", auth.Username)
+	}
+}
+
+func TestStepRequest_validation(t *testing.T) {
+	tests := []struct {
+		name   string
+		config map[string]interface{}
+		path   cty.Path
+	}{
+		{"valid", map[string]interface{}{}, nil},
+		{"url template", map[string]interface{}{"url": "{{base_url}}/users"}, nil},
+		{"url with variables", map[string]interface{}{"url": "https://{{host}}/users/{{id}}"}, nil},
+		{"url variable", map[string]interface{}{"url": "{{url}}"}, nil},
+		{"url template with port", map[string]interface{}{"url": "{{host}}:8080/users?id={{id}}"}, nil},
+		{"invalid url template", map[string]interface{}{"url": "{{x}} not a url"}, cty.GetAttrPath("url")},
+		{"lowercase method", map[string]interface{}{"method": "get"}, nil},
+		{"unknown method", map[string]interface{}{"method": "FETCH"}, cty.GetAttrPath("method")},
+		{"relative url", map[string]interface{}{"url": "/users"}, cty.GetAttrPath("url")},
+		{"url without scheme", map[string]interface{}{"url": "example.com/users"}, cty.GetAttrPath("url")},
+		{"ftp url", map[string]interface{}{"url": "ftp://example.com"}, cty.GetAttrPath("url")},
+		{
+			"unknown auth type",
+			map[string]interface{}{"auth": []interface{}{map[string]interface{}{
+				"username":  "user",
+				"password":  "secret",
+				"auth_type": "bearer",
+			}}},
+			cty.GetAttrPath("auth"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			raw := map[string]interface{}{
+				"bucket_id": "bucket",
+				"test_id":   "test",
+				"method":    "GET",
+				"url":       "https://example.com",
+			}
+			for k, v := range test.config {
+				raw[k] = v
+			}
+
+			diags := resourceRunscopeStepRequest().Validate(terraform.NewResourceConfigRaw(raw))
+			if test.path == nil {
+				if diags.HasError() {
+					t.Errorf("expected no errors, got %s: %s", diags[0].Summary, diags[0].Detail)
+				}
+				return
+			}
+
+			if !diags.HasError() {
+				t.Fatal("expected an error")
+			}
+			// Paths into sets end at the set.
+			if path := diags[0].AttributePath; len(path) < len(test.path) || !path[:len(test.path)].Equals(test.path) {
+				t.Errorf("expected the error at %#v, got %#v", test.path, path)
+			}
+		})
+	}
+}
+
+func TestStepRequest_assertionValues(t *testing.T) {
+	tests := []struct {
+		comparison string
+		value      string
+		err        string
+	}{
+		{"equal", "200", ""},
+		{"equal", "", "assertion.0.value: must be set for comparison equal"},
+		{"has_key", "", "assertion.0.value: must be set for comparison has_key"},
+		{"empty", "", ""},
+		{"is_null", "", ""},
+		{"empty", "x", "assertion.0.value: must not be set for comparison empty"},
+		{"is_null", "x", "assertion.0.value: must not be set for comparison is_null"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.comparison+"/"+test.value, func(t *testing.T) {
+			assertion := map[string]interface{}{
+				"source":     "response_json",
+				"property":   "data.id",
+				"comparison": test.comparison,
+			}
+			if test.value != "" {
+				assertion["value"] = test.value
+			}
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"bucket_id": "bucket",
+				"test_id":   "test",
+				"method":    "GET",
+				"url":       "https://example.com",
+				"assertion": []interface{}{assertion},
+			})
+
+			_, err := resourceRunscopeStepRequest().Diff(context.Background(), nil, config, nil)
+			if test.err == "" && err != nil {
+				t.Errorf("expected no error, got %s", err)
+			}
+			if test.err != "" && (err == nil || !strings.Contains(err.Error(), test.err)) {
+				t.Errorf("expected error %q, got %v", test.err, err)
+			}
+			if test.err != "" {
+				expected := cty.GetAttrPath("assertion").IndexInt(0).GetAttr("value")
+				if pathErr, ok := err.(cty.PathError); !ok || !pathErr.Path.Equals(expected) {
+					t.Errorf("expected the error at %#v, got %#v", expected, err)
+				}
+			}
+		})
+	}
+}
+
+func TestStepRequest_lowercaseMethod(t *testing.T) {
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"bucket_id": "bucket",
+		"test_id":   "test",
+		"method":    "post",
+		"url":       "https://example.com",
+	})
+
+	diff, err := resourceRunscopeStepRequest().Diff(context.Background(), nil, config, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if method := diff.Attributes["method"].New; method != "POST" {
+		t.Errorf("expected method POST to be planned, got %s", method)
+	}
+}
+
+func TestStepSubtest_import(t *testing.T) {
+	ctx := context.Background()
+	server := runscopetest.NewServer()
+	defer server.Close()
+	meta := testProviderConfig(server)
+
+	bucket, err := meta.client.Bucket.Create(ctx, &runscope.BucketCreateOpts{Name: "bucket", TeamUUID: server.TeamID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	test, err := meta.client.Test.Create(ctx, runscope.TestCreateOpts{BucketId: bucket.Key})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	requestOpts := &runscope.StepCreateRequestOpts{}
+	requestOpts.BucketId = bucket.Key
+	requestOpts.TestId = test.Id
+	requestOpts.Method = "GET"
+	requestOpts.StepURL = "https://example.org"
+	request, err := meta.client.Step.CreateRequest(ctx, requestOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	subtestOpts := &runscope.StepCreateSubtestOpts{}
+	subtestOpts.BucketId = bucket.Key
+	subtestOpts.TestId = test.Id
+	subtestOpts.BucketKey = bucket.Key
+	subtestOpts.TestUUID = test.Id
+	subtest, err := meta.client.Step.CreateSubtest(ctx, subtestOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	importer := resourceRunscopeStepSubtest().Importer.StateContext
+	tests := []struct {
+		id         string
+		expectedId string
+		err        string
+	}{
+		{fmt.Sprintf("%s/%s/%s", bucket.Key, test.Id, subtest.ID), subtest.ID, ""},
+		{fmt.Sprintf("%s/%s#2", bucket.Key, test.Id), subtest.ID, ""},
+		{fmt.Sprintf("%s/%s#1", bucket.Key, test.Id), "", "step 1 of test " + test.Id + " is a request step"},
+		// Steps imported by ID aren't checked to be of the right type.
+		{fmt.Sprintf("%s/%s/%s", bucket.Key, test.Id, request.ID), request.ID, ""},
+		{fmt.Sprintf("%s/%s#3", bucket.Key, test.Id), "", "contains only 2 steps"},
+		{fmt.Sprintf("%s/%s", bucket.Key, test.Id), "", "should be in format"},
+	}
+
+	for _, tc := range tests {
+		d := resourceRunscopeStepSubtest().Data(nil)
+		d.SetId(tc.id)
+
+		_, err := importer(ctx, d, meta)
+		if tc.err != "" {
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Errorf("%s: expected error containing %q, got %v", tc.id, tc.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error %s", tc.id, err)
+			continue
+		}
+		if d.Id() != tc.expectedId || d.Get("bucket_id") != bucket.Key || d.Get("test_id") != test.Id {
+			t.Errorf("%s: unexpected import result %s %s/%s", tc.id, d.Id(), d.Get("bucket_id"), d.Get("test_id"))
+		}
+	}
+}
+
 func testAccCheckStepDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*providerConfig).client
 	ctx := context.Background()
@@ -550,6 +857,7 @@ resource "runscope_step" "step" {
     source     = "response_status"
     comparison = "%s"
     property   = "data.id"
+    value      = "%s"
   }
 }
 `
@@ -5,6 +5,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope"
@@ -48,8 +49,10 @@ func resourceRunscopeTest() *schema.Resource {
 				Optional: true,
 			},
 			"default_environment_id": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The environment the test runs in unless told otherwise. Defaults to the environment created with the test.",
 			},
 			"created_at": {
 				Type:     schema.TypeString,
@@ -93,11 +96,26 @@ func resourceTestCreate(ctx context.Context, d *schema.ResourceData, meta interf
 
 	test, err := client.Test.Create(ctx, opts)
 	if err != nil {
-		return diag.Errorf("Failed to create test: %s", err)
+		return apiErrorDiags(resourceRunscopeTest().Schema, "Failed to create test", err)
 	}
 
 	d.SetId(test.Id)
 
+	// A test is always created with an environment of its own as default,
+	// so another default environment can only be set by updating it.
+	if envId, ok := d.GetOk("default_environment_id"); ok && envId.(string) != test.DefaultEnvironmentId {
+		updateOpts := runscope.TestUpdateOpts{}
+		updateOpts.Id = test.Id
+		updateOpts.BucketId = opts.BucketId
+		updateOpts.Name = opts.Name
+		updateOpts.Description = opts.Description
+		updateOpts.DefaultEnvironmentId = envId.(string)
+
+		if _, err := client.Test.Update(ctx, updateOpts); err != nil {
+			return apiErrorDiags(resourceRunscopeTest().Schema, "Failed to set default environment of test", err)
+		}
+	}
+
 	return resourceTestRead(ctx, d, meta)
 }
 
@@ -111,7 +129,7 @@ func resourceTestRead(ctx context.Context, d *schema.ResourceData, meta interfac
 
 	test, err := client.Test.Get(ctx, opts)
 	if err != nil {
-		if isNotFound(err) {
+		if errors.Is(err, runscope.ErrNotFound) {
 			d.SetId("")
 			return nil
 		}
@@ -140,7 +158,7 @@ func resourceTestUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 
 	_, err := client.Test.Update(ctx, opts)
 	if err != nil {
-		return diag.Errorf("Error updating test: %s", err)
+		return apiErrorDiags(resourceRunscopeTest().Schema, "Error updating test", err)
 	}
 
 	return resourceTestRead(ctx, d, meta)
@@ -0,0 +1,220 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope"
+)
+
+// testRunPollInterval is how long to wait between checks of a triggered
+// run's result.
+var testRunPollInterval = 5 * time.Second
+
+func resourceRunscopeTestRun() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTestRunCreate,
+		ReadContext:   resourceTestRunRead,
+		DeleteContext: resourceTestRunDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"bucket_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The bucket of the test to run.",
+			},
+			"test_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the test to run.",
+			},
+			"environment_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The environment to run the test in. Defaults to the test's default environment.",
+			},
+			"initial_variables": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Initial variables passed to the run, overriding those of the environment.",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary values that, when changed, cause the test to be run again.",
+			},
+			"result": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The result of the run, `pass` or `fail`.",
+			},
+			"runs": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The runs started, one for every region of the environment.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"test_run_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"test_run_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"environment_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"result": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+		Description: "Runs a test and waits for its result, failing the apply when the test fails. " +
+			"Use `triggers` to decide when the test is run again.",
+	}
+}
+
+func resourceTestRunCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerConfig).client
+
+	bucketId := d.Get("bucket_id").(string)
+	testId := d.Get("test_id").(string)
+
+	test, err := client.Test.Get(ctx, runscope.TestGetOpts{BucketId: bucketId, Id: testId})
+	if err != nil {
+		return diag.Errorf("Couldn't read test %s: %s", testId, err)
+	}
+
+	opts := runscope.TestTriggerOpts{
+		TriggerURL:    test.TriggerURL,
+		EnvironmentId: d.Get("environment_id").(string),
+		Variables:     expandStringMap(d.Get("initial_variables").(map[string]interface{})),
+	}
+
+	tflog.Debug(ctx, "Triggering test run", map[string]interface{}{"test_id": testId})
+	runs, err := client.Test.Trigger(ctx, opts)
+	if err != nil {
+		return diag.Errorf("Couldn't trigger test %s: %s", testId, err)
+	}
+	if len(runs) < 1 {
+		return diag.Errorf("Triggering test %s started no runs", testId)
+	}
+
+	// The ID is set before waiting so that a failed or timed out run
+	// leaves a tainted resource, which runs the test again on next apply.
+	d.SetId(runs[0].Id)
+
+	results, err := waitForTestRuns(ctx, client, bucketId, testId, runs, d.Timeout(schema.TimeoutCreate))
+	d.Set("runs", flattenTestRuns(runs, results))
+	if err != nil {
+		return diag.Errorf("Couldn't get result of test %s: %s", testId, err)
+	}
+
+	d.Set("result", runscope.ResultPass)
+
+	var failed []string
+	for _, run := range runs {
+		if results[run.Id] == runscope.ResultFail {
+			failed = append(failed, run.URL)
+		}
+	}
+	if len(failed) > 0 {
+		d.Set("result", runscope.ResultFail)
+		return diag.Errorf("Test %s failed, see %s", testId, strings.Join(failed, ", "))
+	}
+
+	return nil
+}
+
+// waitForTestRuns polls the results of runs until all of them are done or
+// timeout passes, returning the latest result of each run by its ID.
+func waitForTestRuns(ctx context.Context, client *runscope.Client, bucketId, testId string, runs []runscope.TestRun, timeout time.Duration) (map[string]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	results := map[string]string{}
+	for {
+		done := true
+		for _, run := range runs {
+			if results[run.Id] == runscope.ResultPass || results[run.Id] == runscope.ResultFail {
+				continue
+			}
+
+			opts := &runscope.ResultGetOpts{Id: run.Id}
+			opts.BucketId = bucketId
+			opts.TestId = testId
+
+			result, err := client.Result.Get(ctx, opts)
+			if err != nil && !errors.Is(err, runscope.ErrNotFound) {
+				return results, err
+			}
+			if err == nil {
+				results[run.Id] = result.Result
+			}
+			if err != nil || !result.Done() {
+				done = false
+			}
+		}
+
+		if done {
+			return results, nil
+		}
+
+		tflog.Debug(ctx, "Waiting for test runs", map[string]interface{}{"results": results})
+
+		select {
+		case <-ctx.Done():
+			return results, fmt.Errorf("timed out waiting for runs to finish after %s", timeout)
+		case <-time.After(testRunPollInterval):
+		}
+	}
+}
+
+func resourceTestRunRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// A run never changes once finished, so there is nothing to refresh.
+	return nil
+}
+
+func resourceTestRunDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Results are kept by Runscope; removing the run from state is enough.
+	d.SetId("")
+	return nil
+}
+
+func flattenTestRuns(runs []runscope.TestRun, results map[string]string) []interface{} {
+	var ret []interface{}
+	for _, run := range runs {
+		ret = append(ret, map[string]interface{}{
+			"test_run_id":    run.Id,
+			"test_run_url":   run.URL,
+			"environment_id": run.EnvironmentId,
+			"region":         run.Region,
+			"result":         results[run.Id],
+		})
+	}
+	return ret
+}
@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope"
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope/runscopetest"
+)
+
+func TestAccTestRun_pass(t *testing.T) {
+	teamId := os.Getenv("RUNSCOPE_TEAM_ID")
+	bucketName := testAccRandomBucketName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccTestRunConfig, bucketName, teamId, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("runscope_test_run.run", "result", "pass"),
+					resource.TestCheckResourceAttr("runscope_test_run.run", "runs.#", "1"),
+					resource.TestCheckResourceAttr("runscope_test_run.run", "runs.0.result", "pass"),
+					resource.TestCheckResourceAttrPair("runscope_test_run.run", "id", "runscope_test_run.run", "runs.0.test_run_id"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccTestRunConfig, bucketName, teamId, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("runscope_test_run.run", "result", "pass"),
+					resource.TestCheckResourceAttr("runscope_test_run.run", "triggers.version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestTestRun_fail(t *testing.T) {
+	ctx := context.Background()
+	server := runscopetest.NewServer()
+	defer server.Close()
+	meta := testProviderConfig(server)
+
+	defer func(interval time.Duration) { testRunPollInterval = interval }(testRunPollInterval)
+	testRunPollInterval = time.Millisecond
+
+	var variables map[string]string
+	server.RunResult = func(_, _ string, v map[string]string) string {
+		variables = v
+		return runscope.ResultFail
+	}
+
+	bucket, err := meta.client.Bucket.Create(ctx, &runscope.BucketCreateOpts{Name: "bucket", TeamUUID: server.TeamID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	test, err := meta.client.Test.Create(ctx, runscope.TestCreateOpts{BucketId: bucket.Key})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceRunscopeTestRun().Schema, map[string]interface{}{
+		"bucket_id":         bucket.Key,
+		"test_id":           test.Id,
+		"initial_variables": map[string]interface{}{"base_url": "https://example.com"},
+	})
+
+	diags := resourceTestRunCreate(ctx, d, meta)
+	if !diags.HasError() {
+		t.Fatal("expected the apply to fail")
+	}
+	if !strings.Contains(diags[0].Summary, "failed") {
+		t.Errorf("unexpected error %s", diags[0].Summary)
+	}
+	if d.Id() == "" {
+		t.Error("expected the failed run to be kept in state")
+	}
+	if result := d.Get("result").(string); result != runscope.ResultFail {
+		t.Errorf("expected result fail, got %s", result)
+	}
+	if variables["base_url"] != "https://example.com" {
+		t.Errorf("expected initial variables to be passed to the run, got %v", variables)
+	}
+}
+
+func TestTestRun_timeout(t *testing.T) {
+	ctx := context.Background()
+	server := runscopetest.NewServer()
+	defer server.Close()
+	meta := testProviderConfig(server)
+
+	bucket, err := meta.client.Bucket.Create(ctx, &runscope.BucketCreateOpts{Name: "bucket", TeamUUID: server.TeamID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	test, err := meta.client.Test.Create(ctx, runscope.TestCreateOpts{BucketId: bucket.Key})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	runs, err := meta.client.Test.Trigger(ctx, runscope.TestTriggerOpts{TriggerURL: test.TriggerURL})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = waitForTestRuns(ctx, meta.client, bucket.Key, test.Id, runs, 10*time.Millisecond)
+	if err == nil || !strings.Contains(err.Error(), "timed out") {
+		t.Errorf("expected to time out, got %v", err)
+	}
+}
+
+const testAccTestRunConfig = `
+resource "runscope_bucket" "bucket" {
+  name      = "%s"
+  team_uuid = "%s"
+}
+
+resource "runscope_test" "test" {
+  bucket_id = runscope_bucket.bucket.id
+  name      = "smoke test"
+}
+
+resource "runscope_test_run" "run" {
+  bucket_id = runscope_bucket.bucket.id
+  test_id   = runscope_test.test.id
+
+  initial_variables = {
+    base_url = "https://example.com"
+  }
+
+  triggers = {
+    version = "%s"
+  }
+}
+`
@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope"
+)
+
+func resourceRunscopeTestSteps() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTestStepsCreate,
+		ReadContext:   resourceTestStepsRead,
+		UpdateContext: resourceTestStepsUpdate,
+		DeleteContext: resourceTestStepsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: func(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				parts := strings.Split(d.Id(), "/")
+				if len(parts) != 2 {
+					return nil, fmt.Errorf("test steps ID for import should be in format bucket_id/test_id")
+				}
+
+				d.Set("bucket_id", parts[0])
+				d.Set("test_id", parts[1])
+				d.SetId(parts[1])
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Schema: map[string]*schema.Schema{
+			"bucket_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The bucket of the test.",
+			},
+			"test_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the test whose steps to order.",
+			},
+			"step_ids": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the steps in the order they should run. Steps of the test not listed run after these.",
+			},
+		},
+		Description: "Enforces the order of the steps of a test.",
+	}
+}
+
+func resourceTestStepsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := reorderTestSteps(ctx, d, meta); diags != nil {
+		return diags
+	}
+
+	d.SetId(d.Get("test_id").(string))
+
+	return resourceTestStepsRead(ctx, d, meta)
+}
+
+func resourceTestStepsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerConfig).client
+
+	test, err := client.Test.Get(ctx, runscope.TestGetOpts{
+		BucketId: d.Get("bucket_id").(string),
+		Id:       d.Get("test_id").(string),
+	})
+	if err != nil {
+		if errors.Is(err, runscope.ErrNotFound) {
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Couldn't read test: %s", err)
+	}
+
+	d.Set("step_ids", orderedStepIds(d.Get("step_ids").([]interface{}), test.Steps))
+
+	return nil
+}
+
+func resourceTestStepsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := reorderTestSteps(ctx, d, meta); diags != nil {
+		return diags
+	}
+
+	return resourceTestStepsRead(ctx, d, meta)
+}
+
+func resourceTestStepsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The steps are left in whatever order they are in.
+	d.SetId("")
+	return nil
+}
+
+func reorderTestSteps(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerConfig).client
+
+	opts := &runscope.StepReorderOpts{}
+	expandStepUriOpts(d, &opts.StepUriOpts)
+	opts.StepIds = expandStringSlice(d.Get("step_ids").([]interface{}))
+
+	unlock := lockTestSteps(d, meta)
+	_, err := client.Step.Reorder(ctx, opts)
+	unlock()
+	if err != nil {
+		return diag.Errorf("Couldn't reorder steps: %s", err)
+	}
+
+	return nil
+}
+
+// orderedStepIds returns the IDs of the steps of a test in their current
+// order, up to the last of the managed steps. Steps not managed only show up
+// when they were moved in between managed ones, so that they are reported
+// as drift while steps added after the managed ones are not. Without
+// managed steps, e.g. when importing, all steps are returned.
+func orderedStepIds(managed []interface{}, steps []runscope.TestStep) []string {
+	wanted := map[string]bool{}
+	for _, id := range managed {
+		wanted[id.(string)] = true
+	}
+
+	ids := []string{}
+	last := len(steps)
+	if len(wanted) > 0 {
+		last = 0
+		for i, step := range steps {
+			if wanted[step.Id] {
+				last = i + 1
+			}
+		}
+	}
+
+	for _, step := range steps[:last] {
+		ids = append(ids, step.Id)
+	}
+	return ids
+}
@@ -0,0 +1,212 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope"
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope/runscopetest"
+)
+
+func TestAccTestSteps_order(t *testing.T) {
+	teamId := os.Getenv("RUNSCOPE_TEAM_ID")
+	bucketName := testAccRandomBucketName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccTestStepsConfig, bucketName, teamId, "c, runscope_step_request.a, runscope_step_request.b"),
+				Check:  testAccCheckTestStepsOrder("runscope_test_steps.order", "c", "a", "b"),
+			},
+			{
+				Config: fmt.Sprintf(testAccTestStepsConfig, bucketName, teamId, "b, runscope_step_request.c, runscope_step_request.a"),
+				Check:  testAccCheckTestStepsOrder("runscope_test_steps.order", "b", "c", "a"),
+			},
+			{
+				ResourceName:      "runscope_test_steps.order",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["runscope_test_steps.order"]
+					if !ok {
+						return "", fmt.Errorf("not found runscope_test_steps.order")
+					}
+					return fmt.Sprintf("%s/%s", rs.Primary.Attributes["bucket_id"], rs.Primary.Attributes["test_id"]), nil
+				},
+			},
+		},
+	})
+}
+
+// testAccCheckTestStepsOrder checks that the steps of the test run in the
+// order of the given step resources, e.g. "b" for runscope_step_request.b.
+func testAccCheckTestStepsOrder(n string, names ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		var expected []string
+		for _, name := range names {
+			step, ok := s.RootModule().Resources["runscope_step_request."+name]
+			if !ok {
+				return fmt.Errorf("Not found: runscope_step_request.%s", name)
+			}
+			expected = append(expected, step.Primary.ID)
+		}
+
+		client := testAccProvider.Meta().(*providerConfig).client
+		test, err := client.Test.Get(context.Background(), runscope.TestGetOpts{
+			BucketId: rs.Primary.Attributes["bucket_id"],
+			Id:       rs.Primary.Attributes["test_id"],
+		})
+		if err != nil {
+			return err
+		}
+
+		var actual []string
+		for _, step := range test.Steps {
+			actual = append(actual, step.Id)
+		}
+		if !reflect.DeepEqual(actual, expected) {
+			return fmt.Errorf("expected steps %v, got %v", expected, actual)
+		}
+
+		return nil
+	}
+}
+
+func TestTestSteps_drift(t *testing.T) {
+	ctx := context.Background()
+	server := runscopetest.NewServer()
+	defer server.Close()
+	meta := testProviderConfig(server)
+
+	bucket, err := meta.client.Bucket.Create(ctx, &runscope.BucketCreateOpts{Name: "bucket", TeamUUID: server.TeamID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	test, err := meta.client.Test.Create(ctx, runscope.TestCreateOpts{BucketId: bucket.Key})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var ids []string
+	for i := 0; i < 3; i++ {
+		opts := &runscope.StepCreateRequestOpts{}
+		opts.BucketId = bucket.Key
+		opts.TestId = test.Id
+		opts.Method = "GET"
+		opts.StepURL = fmt.Sprintf("https://%d.example.com", i)
+		step, err := meta.client.Step.CreateRequest(ctx, opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, step.ID)
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceRunscopeTestSteps().Schema, map[string]interface{}{
+		"bucket_id": bucket.Key,
+		"test_id":   test.Id,
+		"step_ids":  []interface{}{ids[2], ids[0]},
+	})
+	if diags := resourceTestStepsCreate(ctx, d, meta); diags.HasError() {
+		t.Fatal(diags[0].Summary)
+	}
+
+	expected := []interface{}{ids[2], ids[0]}
+	if actual := d.Get("step_ids").([]interface{}); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected step_ids %v after create, got %v", expected, actual)
+	}
+
+	// Someone moves the unmanaged step in between the managed ones.
+	reorderOpts := &runscope.StepReorderOpts{StepIds: []string{ids[2], ids[1], ids[0]}}
+	reorderOpts.BucketId = bucket.Key
+	reorderOpts.TestId = test.Id
+	if _, err := meta.client.Step.Reorder(ctx, reorderOpts); err != nil {
+		t.Fatal(err)
+	}
+
+	if diags := resourceTestStepsRead(ctx, d, meta); diags.HasError() {
+		t.Fatal(diags[0].Summary)
+	}
+
+	expected = []interface{}{ids[2], ids[1], ids[0]}
+	if actual := d.Get("step_ids").([]interface{}); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected drift to show as step_ids %v, got %v", expected, actual)
+	}
+}
+
+func TestOrderedStepIds(t *testing.T) {
+	steps := []runscope.TestStep{{Id: "a"}, {Id: "b"}, {Id: "c"}, {Id: "d"}}
+
+	tests := []struct {
+		name     string
+		managed  []interface{}
+		expected []string
+	}{
+		{"all managed", []interface{}{"a", "b", "c", "d"}, []string{"a", "b", "c", "d"}},
+		{"unmanaged steps after managed ones", []interface{}{"a", "b"}, []string{"a", "b"}},
+		{"unmanaged step in between", []interface{}{"a", "c"}, []string{"a", "b", "c"}},
+		{"managed out of order", []interface{}{"b", "a"}, []string{"a", "b"}},
+		{"managed step removed", []interface{}{"a", "e"}, []string{"a"}},
+		{"import", nil, []string{"a", "b", "c", "d"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if actual := orderedStepIds(test.managed, steps); !reflect.DeepEqual(actual, test.expected) {
+				t.Errorf("expected %v, got %v", test.expected, actual)
+			}
+		})
+	}
+}
+
+const testAccTestStepsConfig = `
+resource "runscope_bucket" "bucket" {
+  name      = "%s"
+  team_uuid = "%s"
+}
+
+resource "runscope_test" "test" {
+  bucket_id = runscope_bucket.bucket.id
+  name      = "ordered test"
+}
+
+resource "runscope_step_request" "a" {
+  bucket_id = runscope_bucket.bucket.id
+  test_id   = runscope_test.test.id
+  method    = "GET"
+  url       = "https://a.example.com"
+}
+
+resource "runscope_step_request" "b" {
+  bucket_id = runscope_bucket.bucket.id
+  test_id   = runscope_test.test.id
+  method    = "GET"
+  url       = "https://b.example.com"
+}
+
+resource "runscope_step_request" "c" {
+  bucket_id = runscope_bucket.bucket.id
+  test_id   = runscope_test.test.id
+  method    = "GET"
+  url       = "https://c.example.com"
+}
+
+resource "runscope_test_steps" "order" {
+  bucket_id = runscope_bucket.bucket.id
+  test_id   = runscope_test.test.id
+  step_ids  = [for step in [runscope_step_request.%s] : step.id]
+}
+`
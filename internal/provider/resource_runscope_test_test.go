@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope"
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope/runscopetest"
 	"os"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -122,6 +124,62 @@ func TestAccTest_update_test(t *testing.T) {
 	})
 }
 
+func TestAccTest_default_environment(t *testing.T) {
+	teamId := os.Getenv("RUNSCOPE_TEAM_ID")
+	bucketName := testAccRandomBucketName()
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckTestDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccTestDefaultEnvironmentConfig, bucketName, teamId, "shared"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("runscope_test.test", "default_environment_id", "runscope_environment.shared", "id"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccTestDefaultEnvironmentConfig, bucketName, teamId, "other"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("runscope_test.test", "default_environment_id", "runscope_environment.other", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestTest_createWithDefaultEnvironment(t *testing.T) {
+	ctx := context.Background()
+	server := runscopetest.NewServer()
+	defer server.Close()
+	meta := testProviderConfig(server)
+
+	bucket, err := meta.client.Bucket.Create(ctx, &runscope.BucketCreateOpts{Name: "bucket", TeamUUID: server.TeamID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	envOpts := &runscope.EnvironmentCreateOpts{}
+	envOpts.BucketId = bucket.Key
+	envOpts.Name = "shared"
+	env, err := meta.client.Environment.Create(ctx, envOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceRunscopeTest().Schema, map[string]interface{}{
+		"bucket_id":              bucket.Key,
+		"name":                   "test",
+		"default_environment_id": env.Id,
+	})
+	if diags := resourceTestCreate(ctx, d, meta); diags.HasError() {
+		t.Fatal(diags[0].Summary)
+	}
+
+	if envId := d.Get("default_environment_id").(string); envId != env.Id {
+		t.Errorf("expected default environment %s, got %s", env.Id, envId)
+	}
+}
+
 func testAccCheckTestDestroy(s *terraform.State) error {
 	ctx := context.Background()
 	client := testAccProvider.Meta().(*providerConfig).client
@@ -211,3 +269,27 @@ resource "runscope_test" "test" {
   description = "runscope custom test description"
 }
 `
+
+const testAccTestDefaultEnvironmentConfig = `
+resource "runscope_bucket" "bucket" {
+  name      = "%s"
+  team_uuid = "%s"
+}
+
+resource "runscope_environment" "shared" {
+  bucket_id = runscope_bucket.bucket.id
+  name      = "shared"
+}
+
+resource "runscope_environment" "other" {
+  bucket_id = runscope_bucket.bucket.id
+  name      = "other"
+}
+
+resource "runscope_test" "test" {
+  bucket_id = runscope_bucket.bucket.id
+  name      = "runscope test"
+
+  default_environment_id = runscope_environment.%s.id
+}
+`
@@ -1,8 +1,11 @@
 package provider
 
 import (
+	"sort"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope"
 )
 
@@ -14,6 +17,14 @@ func expandStringSlice(s []interface{}) []string {
 	return result
 }
 
+func expandStringMap(m map[string]interface{}) map[string]string {
+	result := make(map[string]string, len(m))
+	for k, v := range m {
+		result[k] = v.(string)
+	}
+	return result
+}
+
 func flattenStepVariables(variables []runscope.StepVariable) []interface{} {
 	result := make([]interface{}, len(variables))
 	for i, v := range variables {
@@ -39,12 +50,26 @@ func flattenStepAssertions(assertions []runscope.StepAssertion) []interface{} {
 	return result
 }
 
+// flattenStepHeaders returns headers sorted by name regardless of case,
+// keeping the order of the values of each header.
 func flattenStepHeaders(headers map[string][]string) []interface{} {
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		a, b := strings.ToLower(names[i]), strings.ToLower(names[j])
+		if a != b {
+			return a < b
+		}
+		return names[i] < names[j]
+	})
+
 	result := []interface{}{}
-	for header, values := range headers {
-		for _, value := range values {
+	for _, name := range names {
+		for _, value := range headers[name] {
 			result = append(result, map[string]interface{}{
-				"header": header,
+				"header": name,
 				"value":  value,
 			})
 		}
@@ -52,10 +77,18 @@ func flattenStepHeaders(headers map[string][]string) []interface{} {
 	return result
 }
 
+// flattenFormParameters returns form parameters sorted by name, keeping
+// the order of the values of each parameter.
 func flattenFormParameters(form map[string][]string) []interface{} {
+	names := make([]string, 0, len(form))
+	for name := range form {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
 	result := []interface{}{}
-	for name, values := range form {
-		for _, value := range values {
+	for _, name := range names {
+		for _, value := range form[name] {
 			result = append(result, map[string]interface{}{
 				"name":  name,
 				"value": value,
@@ -65,6 +98,13 @@ func flattenFormParameters(form map[string][]string) []interface{} {
 	return result
 }
 
+// hashHeader hashes header blocks by header name regardless of case, so
+// that a header read back in another case isn't taken for a change.
+func hashHeader(v interface{}) int {
+	h := v.(map[string]interface{})
+	return schema.HashString(strings.ToLower(h["header"].(string)) + "\x00" + h["value"].(string))
+}
+
 func flattenStepAuth(auth runscope.StepAuth) []map[string]interface{} {
 	return []map[string]interface{}{{
 		"username":  auth.Username,
@@ -73,6 +113,22 @@ func flattenStepAuth(auth runscope.StepAuth) []map[string]interface{} {
 	}}
 }
 
+// keepRedacted returns the configured value of a secret unless the API
+// read back something other than a masked value, e.g. "********", so that
+// secrets the API doesn't reveal don't show up as drift.
+func keepRedacted(configured, read string) string {
+	if configured != "" && isRedacted(read) {
+		return configured
+	}
+	return read
+}
+
+// isRedacted reports whether a secret read from the API is masked by
+// asterisks. An empty secret was removed rather than masked.
+func isRedacted(secret string) bool {
+	return secret != "" && strings.Trim(secret, "*") == ""
+}
+
 func expandStepVariables(variables []interface{}) []runscope.StepVariable {
 	result := make([]runscope.StepVariable, len(variables))
 	for i, variable := range variables {
@@ -100,34 +156,59 @@ func expandStepAssertions(assertions []interface{}) []runscope.StepAssertion {
 	return result
 }
 
+// expandHeaders returns the values of headers by header name. Header names
+// differing only in case are one header, named like the first of them in
+// sort order. Values are sorted, as the order of the blocks of a set isn't
+// that of the configuration.
 func expandHeaders(headers []interface{}) map[string][]string {
-	result := map[string][]string{}
-	for _, h := range headers {
-		header := h.(map[string]interface{})
-		name := header["header"].(string)
-		value := header["value"].(string)
-		if _, ok := result[name]; !ok {
-			result[name] = []string{}
+	entries := expandNameValues(headers, "header")
+
+	names := map[string]string{}
+	for _, entry := range entries {
+		key := strings.ToLower(entry[0])
+		if name, ok := names[key]; !ok || entry[0] < name {
+			names[key] = entry[0]
 		}
-		result[name] = append(result[name], value)
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i][1] < entries[j][1]
+	})
+	result := map[string][]string{}
+	for _, entry := range entries {
+		name := names[strings.ToLower(entry[0])]
+		result[name] = append(result[name], entry[1])
 	}
 	return result
 }
 
+// expandStepForm returns the values of form parameters by name, with the
+// values of each parameter sorted like those of headers.
 func expandStepForm(formParameters []interface{}) map[string][]string {
 	result := map[string][]string{}
-	for _, fp := range formParameters {
-		formParameter := fp.(map[string]interface{})
-		name := formParameter["name"].(string)
-		value := formParameter["value"].(string)
-		if _, ok := result[name]; !ok {
-			result[name] = []string{}
-		}
-		result[name] = append(result[name], value)
+	for _, entry := range expandNameValues(formParameters, "name") {
+		result[entry[0]] = append(result[entry[0]], entry[1])
 	}
 	return result
 }
 
+// expandNameValues returns the name, taken from key, and value of each
+// block sorted by name and then value.
+func expandNameValues(blocks []interface{}, key string) [][2]string {
+	entries := make([][2]string, len(blocks))
+	for i, b := range blocks {
+		block := b.(map[string]interface{})
+		entries[i] = [2]string{block[key].(string), block["value"].(string)}
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i][0] != entries[j][0] {
+			return entries[i][0] < entries[j][0]
+		}
+		return entries[i][1] < entries[j][1]
+	})
+	return entries
+}
+
 func expandStepAuth(auth []interface{}) runscope.StepAuth {
 	result := runscope.StepAuth{}
 	if len(auth) > 0 {
@@ -140,7 +221,7 @@ func expandStepAuth(auth []interface{}) runscope.StepAuth {
 }
 
 func flattenTime(t time.Time) string {
-	if t.Unix() == 0 {
+	if t.IsZero() || t.Unix() == 0 {
 		return ""
 	}
 
@@ -166,3 +247,79 @@ func flattenRemoteAgents(ra []*runscope.RemoteAgent) []map[string]interface{} {
 	}
 	return remoteAgents
 }
+
+func flattenResults(results []*runscope.Result) []interface{} {
+	ret := make([]interface{}, len(results))
+	for i, r := range results {
+		steps := make([]interface{}, len(r.Requests))
+		for j, request := range r.Requests {
+			steps[j] = flattenResultRequest(request)
+		}
+
+		ret[i] = map[string]interface{}{
+			"test_run_id":        r.TestRunId,
+			"test_run_url":       r.TestRunURL,
+			"environment_id":     r.EnvironmentId,
+			"environment_name":   r.EnvironmentName,
+			"region":             r.Region,
+			"result":             r.Result,
+			"started_at":         flattenTime(r.StartedAt),
+			"finished_at":        flattenTime(r.FinishedAt),
+			"assertions_defined": r.AssertionsDefined,
+			"assertions_passed":  r.AssertionsPassed,
+			"assertions_failed":  r.AssertionsFailed,
+			"requests_executed":  r.RequestsExecuted,
+			"steps":              steps,
+		}
+	}
+	return ret
+}
+
+func flattenResultRequest(r runscope.ResultRequest) map[string]interface{} {
+	assertions := make([]interface{}, len(r.Assertions))
+	for i, a := range r.Assertions {
+		assertions[i] = map[string]interface{}{
+			"source":       a.Source,
+			"property":     a.Property,
+			"comparison":   a.Comparison,
+			"target_value": a.TargetValue,
+			"actual_value": a.ActualValue,
+			"result":       a.Result,
+			"error":        a.Error,
+		}
+	}
+
+	variables := make([]interface{}, len(r.Variables))
+	for i, v := range r.Variables {
+		variables[i] = map[string]interface{}{
+			"name":     v.Name,
+			"source":   v.Source,
+			"property": v.Property,
+			"value":    v.Value,
+			"result":   v.Result,
+			"error":    v.Error,
+		}
+	}
+
+	scripts := make([]interface{}, len(r.Scripts))
+	for i, s := range r.Scripts {
+		scripts[i] = map[string]interface{}{
+			"output": s.Output,
+			"result": s.Result,
+			"error":  s.Error,
+		}
+	}
+
+	return map[string]interface{}{
+		"step_id":              r.StepId,
+		"step_type":            r.StepType,
+		"method":               r.Method,
+		"url":                  r.URL,
+		"result":               r.Result,
+		"response_status_code": r.ResponseStatusCode,
+		"response_time_ms":     int(r.ResponseTime / time.Millisecond),
+		"assertions":           assertions,
+		"variables":            variables,
+		"scripts":              scripts,
+	}
+}
@@ -0,0 +1,184 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+)
+
+func header(name, value string) map[string]interface{} {
+	return map[string]interface{}{"header": name, "value": value}
+}
+
+func formParameter(name, value string) map[string]interface{} {
+	return map[string]interface{}{"name": name, "value": value}
+}
+
+func TestFlattenStepHeaders(t *testing.T) {
+	tests := []struct {
+		name     string
+		headers  map[string][]string
+		expected []interface{}
+	}{
+		{"empty", nil, []interface{}{}},
+		{
+			"sorted by name regardless of case",
+			map[string][]string{"X-Trace": {"1"}, "accept": {"*/*"}, "Content-Type": {"application/json"}},
+			[]interface{}{header("accept", "*/*"), header("Content-Type", "application/json"), header("X-Trace", "1")},
+		},
+		{
+			"values keep their order",
+			map[string][]string{"Accept": {"text/html", "application/json"}},
+			[]interface{}{header("Accept", "text/html"), header("Accept", "application/json")},
+		},
+		{
+			"names differing in case",
+			map[string][]string{"accept": {"b"}, "Accept": {"a"}},
+			[]interface{}{header("Accept", "a"), header("accept", "b")},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			for i := 0; i < 10; i++ {
+				if actual := flattenStepHeaders(test.headers); !reflect.DeepEqual(actual, test.expected) {
+					t.Fatalf("expected %v, got %v", test.expected, actual)
+				}
+			}
+		})
+	}
+}
+
+func TestExpandHeaders(t *testing.T) {
+	tests := []struct {
+		name     string
+		headers  []interface{}
+		expected map[string][]string
+	}{
+		{"empty", nil, map[string][]string{}},
+		{
+			"single values",
+			[]interface{}{header("Accept", "*/*"), header("X-Trace", "1")},
+			map[string][]string{"Accept": {"*/*"}, "X-Trace": {"1"}},
+		},
+		{
+			"values sorted",
+			[]interface{}{header("Accept", "text/html"), header("Accept", "application/json")},
+			map[string][]string{"Accept": {"application/json", "text/html"}},
+		},
+		{
+			"names differing in case are merged",
+			[]interface{}{header("accept", "text/html"), header("Accept", "application/json")},
+			map[string][]string{"Accept": {"application/json", "text/html"}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if actual := expandHeaders(test.headers); !reflect.DeepEqual(actual, test.expected) {
+				t.Errorf("expected %v, got %v", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestHashHeader(t *testing.T) {
+	tests := []struct {
+		name  string
+		a, b  map[string]interface{}
+		equal bool
+	}{
+		{"same header", header("Accept", "*/*"), header("Accept", "*/*"), true},
+		{"name in other case", header("Content-Type", "text/plain"), header("content-type", "text/plain"), true},
+		{"value in other case", header("Accept", "text/plain"), header("Accept", "TEXT/PLAIN"), false},
+		{"other name", header("Accept", "*/*"), header("Accept-Encoding", "*/*"), false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if equal := hashHeader(test.a) == hashHeader(test.b); equal != test.equal {
+				t.Errorf("expected equal hashes to be %t, got %t", test.equal, equal)
+			}
+		})
+	}
+}
+
+func TestFlattenFormParameters(t *testing.T) {
+	tests := []struct {
+		name     string
+		form     map[string][]string
+		expected []interface{}
+	}{
+		{"empty", nil, []interface{}{}},
+		{
+			"sorted by name",
+			map[string][]string{"b": {"2"}, "a": {"1"}, "c": {"3"}},
+			[]interface{}{formParameter("a", "1"), formParameter("b", "2"), formParameter("c", "3")},
+		},
+		{
+			"values keep their order",
+			map[string][]string{"a": {"2", "1"}},
+			[]interface{}{formParameter("a", "2"), formParameter("a", "1")},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			for i := 0; i < 10; i++ {
+				if actual := flattenFormParameters(test.form); !reflect.DeepEqual(actual, test.expected) {
+					t.Fatalf("expected %v, got %v", test.expected, actual)
+				}
+			}
+		})
+	}
+}
+
+func TestExpandStepForm(t *testing.T) {
+	tests := []struct {
+		name     string
+		form     []interface{}
+		expected map[string][]string
+	}{
+		{"empty", nil, map[string][]string{}},
+		{
+			"values sorted",
+			[]interface{}{formParameter("a", "2"), formParameter("b", "3"), formParameter("a", "1")},
+			map[string][]string{"a": {"1", "2"}, "b": {"3"}},
+		},
+		{
+			"names are case sensitive",
+			[]interface{}{formParameter("a", "1"), formParameter("A", "2")},
+			map[string][]string{"a": {"1"}, "A": {"2"}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if actual := expandStepForm(test.form); !reflect.DeepEqual(actual, test.expected) {
+				t.Errorf("expected %v, got %v", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestKeepRedacted(t *testing.T) {
+	tests := []struct {
+		name       string
+		configured string
+		read       string
+		expected   string
+	}{
+		{"masked", "secret", "********", "secret"},
+		{"removed", "secret", "", ""},
+		{"changed", "secret", "other", "other"},
+		{"not configured", "", "********", "********"},
+		{"not masked", "", "", ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if actual := keepRedacted(test.configured, test.read); actual != test.expected {
+				t.Errorf("expected %q, got %q", test.expected, actual)
+			}
+		})
+	}
+}
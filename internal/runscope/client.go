@@ -7,16 +7,30 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"reflect"
 	"strings"
+	"time"
 )
 
 const DefaultEndpoint = "https://api.runscope.com"
 
+const (
+	DefaultMaxRetries   = 4
+	DefaultRetryWaitMin = 1 * time.Second
+	DefaultRetryWaitMax = 30 * time.Second
+)
+
 type Client struct {
 	endpoint   string
 	token      string
 	httpClient *http.Client
 
+	maxRetries   int
+	retryWaitMin time.Duration
+	retryWaitMax time.Duration
+
+	limiter *rateLimiter
+
 	Test        TestClient
 	Environment EnvironmentClient
 	Bucket      BucketClient
@@ -25,17 +39,23 @@ type Client struct {
 	Step        StepClient
 	RemoteAgent RemoteAgentClient
 	Account     AccountClient
+	Result      ResultClient
 }
 
 func NewClient(options ...ClientOption) *Client {
 	client := &Client{
-		endpoint:   DefaultEndpoint,
-		httpClient: &http.Client{},
+		endpoint:     DefaultEndpoint,
+		httpClient:   &http.Client{},
+		maxRetries:   DefaultMaxRetries,
+		retryWaitMin: DefaultRetryWaitMin,
+		retryWaitMax: DefaultRetryWaitMax,
+		limiter:      newRateLimiter(0, 0),
 	}
 
 	for _, option := range options {
 		option(client)
 	}
+	client.limiter.maxBlock = client.retryWaitMax
 
 	client.Test = TestClient{client: client}
 	client.Environment = EnvironmentClient{client: client}
@@ -45,6 +65,7 @@ func NewClient(options ...ClientOption) *Client {
 	client.Step = StepClient{client: client}
 	client.RemoteAgent = RemoteAgentClient{client: client}
 	client.Account = AccountClient{client: client}
+	client.Result = ResultClient{client: client}
 
 	return client
 }
@@ -63,8 +84,40 @@ func WithEndpoint(endpoint string) ClientOption {
 	}
 }
 
+// WithMaxRetries sets how many times a failed request is retried.
+// Zero disables retries.
+func WithMaxRetries(retries int) ClientOption {
+	return func(client *Client) {
+		client.maxRetries = retries
+	}
+}
+
+// WithRetryWait sets the bounds of the exponential backoff used
+// between retries. Requests the API asks to retry after more than max
+// aren't retried.
+func WithRetryWait(min, max time.Duration) ClientOption {
+	return func(client *Client) {
+		client.retryWaitMin = min
+		client.retryWaitMax = max
+	}
+}
+
+// WithRateLimit limits the client to requestsPerSecond requests, allowing
+// bursts of up to burst requests. A burst below one defaults to the rate
+// rounded up. A rate of zero or less disables the limit.
+func WithRateLimit(requestsPerSecond float64, burst int) ClientOption {
+	return func(client *Client) {
+		client.limiter.setRate(requestsPerSecond, burst)
+	}
+}
+
+// NewRequest creates a request for path relative to the API endpoint.
+// Absolute URLs, such as test trigger URLs, are used as is.
 func (c *Client) NewRequest(ctx context.Context, method, path string, body interface{}) (*http.Request, error) {
 	apiUrl := c.endpoint + path
+	if strings.HasPrefix(path, "https://") || strings.HasPrefix(path, "http://") {
+		apiUrl = path
+	}
 
 	req, err := func() (*http.Request, error) {
 		if body == nil {
@@ -92,24 +145,55 @@ func (c *Client) NewRequest(ctx context.Context, method, path string, body inter
 }
 
 func (c *Client) Do(r *http.Request, v interface{}) error {
-	resp, err := c.httpClient.Do(r)
-	if err != nil {
-		return fmt.Errorf("failed to do: %w", err)
-	}
+	var (
+		resp       *http.Response
+		body       []byte
+		err        error
+		retryAfter time.Duration
+	)
 
-	body, err := ioutil.ReadAll(resp.Body)
-	defer resp.Body.Close()
+	for attempt := 0; ; attempt++ {
+		resp, body, err = c.do(r)
+		if attempt >= c.maxRetries || !c.shouldRetry(r, resp, err) {
+			break
+		}
+
+		wait, ok := c.backoff(attempt, resp)
+		if !ok {
+			retryAfter = wait
+			break
+		}
+		if deadline, ok := r.Context().Deadline(); ok && time.Now().Add(wait).After(deadline) {
+			break
+		}
+
+		if err := sleep(r.Context(), wait); err != nil {
+			return fmt.Errorf("failed to do: %w", err)
+		}
+
+		if r.Body != nil {
+			if r.GetBody == nil {
+				break
+			}
+			if r.Body, err = r.GetBody(); err != nil {
+				return fmt.Errorf("failed to rewind body: %w", err)
+			}
+		}
+	}
 
 	if err != nil {
-		return fmt.Errorf("failed to read body: %w", err)
+		return err
 	}
 
 	if resp.StatusCode >= 400 {
-		err = Error{
+		apiErr := Error{
 			Response: resp,
 		}
-		json.Unmarshal(body, &err)
-		return fmt.Errorf("unexpected response code: %w", err)
+		json.Unmarshal(body, &apiErr)
+		if retryAfter > 0 {
+			return fmt.Errorf("not retrying after %s, longer than the maximum retry wait of %s: %w", retryAfter, c.retryWaitMax, apiErr)
+		}
+		return fmt.Errorf("unexpected response code: %w", apiErr)
 	}
 
 	if v != nil {
@@ -120,3 +204,113 @@ func (c *Client) Do(r *http.Request, v interface{}) error {
 
 	return nil
 }
+
+func (c *Client) do(r *http.Request) (*http.Response, []byte, error) {
+	if err := c.limiter.Wait(r.Context()); err != nil {
+		return nil, nil, fmt.Errorf("failed to wait for rate limit: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(r)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to do: %w", err)
+	}
+	c.limiter.update(resp)
+
+	body, err := ioutil.ReadAll(resp.Body)
+	defer resp.Body.Close()
+
+	if err != nil {
+		return resp, nil, fmt.Errorf("failed to read body: %w", err)
+	}
+
+	return resp, body, nil
+}
+
+// update replaces the resource at path with body, keeping the fields of
+// the resource that body doesn't model. The API resets fields left out of
+// a PUT, so the resource is read first and body is laid over it; settings
+// added to the API later or only set in the UI are then sent back as read.
+// Fields body models but leaves out, e.g. empty omitempty fields, and
+// fields in omit are dropped from what was read rather than sent back.
+// Read-only fields such as id are never sent.
+func (c *Client) update(ctx context.Context, path string, body, v interface{}, omit ...string) error {
+	req, err := c.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return err
+	}
+
+	var current struct {
+		Data map[string]json.RawMessage `json:"data"`
+	}
+	if err := c.Do(req, &current); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	merged := current.Data
+	if merged == nil {
+		merged = map[string]json.RawMessage{}
+	}
+	for _, name := range append(jsonFieldNames(reflect.TypeOf(body)), omit...) {
+		delete(merged, name)
+	}
+	for name, value := range fields {
+		merged[name] = value
+	}
+	for _, name := range readOnlyFields {
+		delete(merged, name)
+	}
+
+	req, err = c.NewRequest(ctx, http.MethodPut, path, merged)
+	if err != nil {
+		return err
+	}
+
+	return c.Do(req, v)
+}
+
+// readOnlyFields are the fields the API sets itself, which update leaves
+// out of the resources it sends back.
+var readOnlyFields = []string{"id", "created_at", "created_by", "trigger_url"}
+
+// jsonFieldNames returns the names of the JSON object fields of a struct
+// type, including those of embedded structs.
+func jsonFieldNames(t reflect.Type) []string {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var names []string
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name := strings.Split(tag, ",")[0]
+		if field.Anonymous && name == "" {
+			names = append(names, jsonFieldNames(field.Type)...)
+			continue
+		}
+		if field.PkgPath != "" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		names = append(names, name)
+	}
+	return names
+}
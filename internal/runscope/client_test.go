@@ -0,0 +1,80 @@
+package runscope
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestClient_update(t *testing.T) {
+	var sent map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			w.Write([]byte(`{"data": {"id": "1", "name": "old", "parent": "2", "unmodeled": {"enabled": true}, "steps": [], "created_at": 1, "created_by": {"id": "3"}, "trigger_url": "https://example.com/trigger"}}`))
+		case http.MethodPut:
+			if err := json.NewDecoder(r.Body).Decode(&sent); err != nil {
+				t.Error(err)
+			}
+			w.Write([]byte(`{"data": {"id": "1", "name": "new"}}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(WithEndpoint(server.URL), WithMaxRetries(0))
+
+	body := struct {
+		Name   string `json:"name"`
+		Parent string `json:"parent,omitempty"`
+	}{Name: "new"}
+	var resp struct {
+		Data struct {
+			Name string `json:"name"`
+		} `json:"data"`
+	}
+	if err := client.update(context.Background(), "/things/1", &body, &resp, "steps"); err != nil {
+		t.Fatal(err)
+	}
+
+	if sent["name"] != "new" {
+		t.Errorf("expected name new to be sent, got %v", sent["name"])
+	}
+	for _, name := range []string{"id", "created_at", "created_by", "trigger_url"} {
+		if _, ok := sent[name]; ok {
+			t.Errorf("expected read-only field %s not to be sent, got %v", name, sent[name])
+		}
+	}
+	if unmodeled, ok := sent["unmodeled"].(map[string]interface{}); !ok || unmodeled["enabled"] != true {
+		t.Errorf("expected unmodeled field to be sent back, got %v", sent["unmodeled"])
+	}
+	if _, ok := sent["steps"]; ok {
+		t.Error("expected omitted field not to be sent")
+	}
+	if _, ok := sent["parent"]; ok {
+		t.Errorf("expected cleared field not to be sent back, got %v", sent["parent"])
+	}
+	if resp.Data.Name != "new" {
+		t.Errorf("expected response to be decoded, got %v", resp.Data.Name)
+	}
+}
+
+func TestJsonFieldNames(t *testing.T) {
+	type base struct {
+		Name string `json:"name"`
+	}
+	type body struct {
+		base
+		Parent  string `json:"parent,omitempty"`
+		Ignored string `json:"-"`
+		Plain   string
+		private string
+	}
+
+	expected := []string{"name", "parent", "Plain"}
+	if actual := jsonFieldNames(reflect.TypeOf(&body{})); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+}
@@ -154,6 +154,31 @@ func (opts *EnvironmentUriOpts) BaseURL() string {
 	return fmt.Sprintf("/buckets/%s/tests/%s/environments", opts.BucketId, opts.TestId)
 }
 
+type EnvironmentListOpts struct {
+	EnvironmentUriOpts
+}
+
+// List returns the shared environments of a bucket, or the environments of
+// a test when TestId is set.
+func (c *EnvironmentClient) List(ctx context.Context, opts *EnvironmentListOpts) ([]*Environment, error) {
+	req, err := c.client.NewRequest(ctx, http.MethodGet, opts.BaseURL(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp schema.EnvironmentListResponse
+	err = c.client.Do(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	envs := make([]*Environment, len(resp.Environments))
+	for i, env := range resp.Environments {
+		envs[i] = EnvironmentFromSchema(&env)
+	}
+	return envs, nil
+}
+
 type EnvironmentCreateOpts struct {
 	EnvironmentUriOpts
 	EnvironmentBase
@@ -210,13 +235,8 @@ func (c *EnvironmentClient) Update(ctx context.Context, opts *EnvironmentUpdateO
 	body := &schema.EnvironmentUpdateRequest{}
 	opts.EnvironmentBase.setRequest(&body.EnvironmentBase)
 
-	req, err := c.client.NewRequest(ctx, http.MethodPut, opts.URL(), &body)
-	if err != nil {
-		return nil, err
-	}
-
 	var resp schema.EnvironmentUpdateResponse
-	err = c.client.Do(req, &resp)
+	err := c.client.update(ctx, opts.URL(), &body, &resp)
 	if err != nil {
 		return nil, err
 	}
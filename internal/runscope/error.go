@@ -1,8 +1,21 @@
 package runscope
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"sort"
+	"strings"
+)
+
+// Sentinel errors matching the kind of an Error with errors.Is.
+var (
+	ErrNotFound     = errors.New("not found")
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrForbidden    = errors.New("forbidden")
+	ErrRateLimited  = errors.New("rate limited")
+	ErrValidation   = errors.New("validation failed")
 )
 
 type Error struct {
@@ -10,9 +23,20 @@ type Error struct {
 	E        struct {
 		Status  int    `json:"status"`
 		Message string `json:"message"`
+		// Errors holds the messages of fields rejected by a validation
+		// failure, keyed by field and nested like the request body.
+		Errors json.RawMessage `json:"errors"`
 	} `json:"error"`
 }
 
+// FieldError is a field of a request rejected by the API.
+type FieldError struct {
+	// Field is the path of the field in the request body, with the names
+	// of nested fields separated by dots, e.g. "auth.username".
+	Field   string
+	Message string
+}
+
 func (e Error) Status() int {
 	if e.E.Status != 0 {
 		return e.E.Status
@@ -26,5 +50,71 @@ func (e Error) Error() string {
 		message = e.Response.Status
 	}
 
+	for _, field := range e.FieldErrors() {
+		message += fmt.Sprintf("; %s: %s", field.Field, field.Message)
+	}
+
 	return fmt.Sprintf("%d %s", e.Status(), message)
 }
+
+// Is reports whether target is the sentinel error matching the status of e.
+func (e Error) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.Status() == http.StatusNotFound
+	case ErrUnauthorized:
+		return e.Status() == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.Status() == http.StatusForbidden
+	case ErrRateLimited:
+		return e.Status() == http.StatusTooManyRequests
+	case ErrValidation:
+		return e.Status() == http.StatusBadRequest || e.Status() == http.StatusUnprocessableEntity
+	}
+	return false
+}
+
+// FieldErrors returns the fields rejected by a validation failure, sorted
+// by field.
+func (e Error) FieldErrors() []FieldError {
+	if len(e.E.Errors) == 0 {
+		return nil
+	}
+
+	var fields []FieldError
+	collectFieldErrors(&fields, "", e.E.Errors)
+	sort.SliceStable(fields, func(i, j int) bool {
+		return fields[i].Field < fields[j].Field
+	})
+	return fields
+}
+
+// collectFieldErrors appends the messages of raw to fields. Messages are
+// given as a string or a list of strings per field, and fields of nested
+// objects as objects of their own.
+func collectFieldErrors(fields *[]FieldError, prefix string, raw json.RawMessage) {
+	var message string
+	if err := json.Unmarshal(raw, &message); err == nil {
+		*fields = append(*fields, FieldError{Field: prefix, Message: message})
+		return
+	}
+
+	var messages []string
+	if err := json.Unmarshal(raw, &messages); err == nil {
+		if len(messages) > 0 {
+			*fields = append(*fields, FieldError{Field: prefix, Message: strings.Join(messages, " ")})
+		}
+		return
+	}
+
+	var nested map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &nested); err == nil {
+		for name, value := range nested {
+			field := name
+			if prefix != "" {
+				field = prefix + "." + name
+			}
+			collectFieldErrors(fields, field, value)
+		}
+	}
+}
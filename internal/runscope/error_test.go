@@ -1,8 +1,13 @@
 package runscope
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
+	"net/http/httptest"
+	"reflect"
 	"testing"
 )
 
@@ -36,3 +41,108 @@ const runscopeInvalidTokenResponse = `
   }
 }
 `
+
+func TestClient_Do_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(runscopeInvalidTokenResponse))
+	}))
+	defer server.Close()
+
+	client := NewClient(WithEndpoint(server.URL))
+	req, err := client.NewRequest(context.Background(), http.MethodGet, "/account", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = client.Do(req, nil)
+
+	var runscopeErr Error
+	if !errors.As(err, &runscopeErr) {
+		t.Fatalf("Expected a runscope Error, got %v", err)
+	}
+	if runscopeErr.Status() != 403 {
+		t.Errorf("Expected status 403, got %d", runscopeErr.Status())
+	}
+}
+
+const runscopeValidationResponse = `
+{
+  "data": {},
+  "meta": {
+    "status": "error"
+  },
+  "error": {
+    "status": 400,
+    "message": "Invalid request.",
+    "errors": {
+      "url": ["Not a valid URL."],
+      "method": "Must be one of GET, POST.",
+      "assertions": {
+        "1": {
+          "comparison": ["Not a valid comparison.", "Required."]
+        }
+      }
+    }
+  }
+}
+`
+
+func TestError_Is(t *testing.T) {
+	tests := []struct {
+		status int
+		target error
+	}{
+		{http.StatusNotFound, ErrNotFound},
+		{http.StatusUnauthorized, ErrUnauthorized},
+		{http.StatusForbidden, ErrForbidden},
+		{http.StatusTooManyRequests, ErrRateLimited},
+		{http.StatusBadRequest, ErrValidation},
+		{http.StatusUnprocessableEntity, ErrValidation},
+	}
+
+	sentinels := []error{ErrNotFound, ErrUnauthorized, ErrForbidden, ErrRateLimited, ErrValidation}
+	for _, test := range tests {
+		err := fmt.Errorf("unexpected response code: %w", Error{Response: &http.Response{StatusCode: test.status}})
+		for _, sentinel := range sentinels {
+			if errors.Is(err, sentinel) != (sentinel == test.target) {
+				t.Errorf("expected errors.Is(%d, %q) to be %t", test.status, sentinel, sentinel == test.target)
+			}
+		}
+	}
+}
+
+func TestError_FieldErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(runscopeValidationResponse))
+	}))
+	defer server.Close()
+
+	client := NewClient(WithEndpoint(server.URL))
+	req, err := client.NewRequest(context.Background(), http.MethodPost, "/buckets/bucket/tests/test/steps", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = client.Do(req, nil)
+	if !errors.Is(err, ErrValidation) {
+		t.Fatalf("Expected a validation error, got %v", err)
+	}
+
+	var runscopeErr Error
+	errors.As(err, &runscopeErr)
+	expected := []FieldError{
+		{Field: "assertions.1.comparison", Message: "Not a valid comparison. Required."},
+		{Field: "method", Message: "Must be one of GET, POST."},
+		{Field: "url", Message: "Not a valid URL."},
+	}
+	if !reflect.DeepEqual(runscopeErr.FieldErrors(), expected) {
+		t.Errorf("Expected field errors %v, got %v", expected, runscopeErr.FieldErrors())
+	}
+
+	expectedError := "400 Invalid request.; assertions.1.comparison: Not a valid comparison. Required.; method: Must be one of GET, POST.; url: Not a valid URL."
+	if runscopeErr.Error() != expectedError {
+		t.Errorf("Expected %s error message, got %s", expectedError, runscopeErr.Error())
+	}
+}
@@ -0,0 +1,157 @@
+package runscope
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRateLimiter_Reserve(t *testing.T) {
+	now := time.Date(2022, 5, 1, 12, 0, 0, 0, time.UTC)
+	l := newRateLimiter(2, 2)
+	l.now = func() time.Time { return now }
+	l.setRate(2, 2)
+
+	for i := 0; i < 2; i++ {
+		if wait := l.reserve(); wait != 0 {
+			t.Fatalf("expected burst request %d to pass, got wait %s", i, wait)
+		}
+	}
+
+	if wait := l.reserve(); wait != 500*time.Millisecond {
+		t.Errorf("expected to wait 500ms once the burst is spent, got %s", wait)
+	}
+
+	now = now.Add(500 * time.Millisecond)
+	if wait := l.reserve(); wait != 0 {
+		t.Errorf("expected a token after 500ms, got wait %s", wait)
+	}
+}
+
+func TestRateLimiter_Unlimited(t *testing.T) {
+	l := newRateLimiter(0, 0)
+	for i := 0; i < 100; i++ {
+		if wait := l.reserve(); wait != 0 {
+			t.Fatalf("expected no wait without a rate, got %s", wait)
+		}
+	}
+}
+
+func TestRateLimiter_UpdateFromHeaders(t *testing.T) {
+	now := time.Date(2022, 5, 1, 12, 0, 0, 0, time.UTC)
+	l := newRateLimiter(0, 0)
+	l.now = func() time.Time { return now }
+	l.maxBlock = time.Minute
+
+	tests := []struct {
+		name     string
+		status   int
+		headers  map[string]string
+		expected time.Duration
+	}{
+		{
+			name:     "quota left",
+			status:   http.StatusOK,
+			headers:  map[string]string{"X-RateLimit-Remaining": "10", "X-RateLimit-Reset": "30"},
+			expected: 0,
+		},
+		{
+			name:     "quota exhausted with delta reset",
+			status:   http.StatusOK,
+			headers:  map[string]string{"X-RateLimit-Remaining": "0", "X-RateLimit-Reset": "5"},
+			expected: 5 * time.Second,
+		},
+		{
+			name:     "quota exhausted with timestamp reset",
+			status:   http.StatusOK,
+			headers:  map[string]string{"X-RateLimit-Remaining": "0", "X-RateLimit-Reset": "1651406410"},
+			expected: 10 * time.Second,
+		},
+		{
+			name:     "reset far in the future",
+			status:   http.StatusOK,
+			headers:  map[string]string{"X-RateLimit-Remaining": "0", "X-RateLimit-Reset": "4102444800"},
+			expected: time.Minute,
+		},
+		{
+			name:     "too many requests for too long",
+			status:   http.StatusTooManyRequests,
+			headers:  map[string]string{"Retry-After": "3600"},
+			expected: time.Minute,
+		},
+		{
+			name:     "too many requests",
+			status:   http.StatusTooManyRequests,
+			headers:  map[string]string{"Retry-After": "7"},
+			expected: 7 * time.Second,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			l.blockedUntil = time.Time{}
+			resp := &http.Response{StatusCode: test.status, Header: http.Header{}}
+			for k, v := range test.headers {
+				resp.Header.Set(k, v)
+			}
+
+			l.update(resp)
+
+			if wait := l.reserve(); wait != test.expected {
+				t.Errorf("expected wait %s, got %s", test.expected, wait)
+			}
+		})
+	}
+}
+
+func TestClient_Do_RateLimitBlockIsCapped(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", "4102444800")
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(WithEndpoint(server.URL), WithRetryWait(time.Millisecond, 50*time.Millisecond))
+
+	start := time.Now()
+	for i := 0; i < 2; i++ {
+		req, err := client.NewRequest(context.Background(), http.MethodGet, "/account", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := client.Do(req, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if elapsed := time.Since(start); elapsed < 40*time.Millisecond || elapsed > time.Second {
+		t.Errorf("expected the second request to wait for the capped block, took %s", elapsed)
+	}
+}
+
+func TestClient_Do_RateLimited(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(WithEndpoint(server.URL), WithRateLimit(20, 1))
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		req, err := client.NewRequest(context.Background(), http.MethodGet, "/account", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := client.Do(req, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Errorf("expected requests to be throttled, took %s", elapsed)
+	}
+}
@@ -0,0 +1,264 @@
+package runscope
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope/schema"
+)
+
+const (
+	ResultPass    = "pass"
+	ResultFail    = "fail"
+	ResultWorking = "working"
+	ResultQueued  = "queued"
+)
+
+// Result is the outcome of a test run.
+type Result struct {
+	TestRunId         string
+	TestRunURL        string
+	TestId            string
+	BucketId          string
+	EnvironmentId     string
+	EnvironmentName   string
+	Region            string
+	Result            string
+	StartedAt         time.Time
+	FinishedAt        time.Time
+	AssertionsDefined int
+	AssertionsPassed  int
+	AssertionsFailed  int
+	RequestsExecuted  int
+	Requests          []ResultRequest
+}
+
+// Done reports whether the run has finished, either passing or failing.
+func (r *Result) Done() bool {
+	return r.Result == ResultPass || r.Result == ResultFail
+}
+
+// ResultRequest is the outcome of a single step of a test run.
+type ResultRequest struct {
+	StepId             string
+	StepType           string
+	Method             string
+	URL                string
+	Result             string
+	ResponseStatusCode int
+	ResponseTime       time.Duration
+	ResponseSizeBytes  int
+	Assertions         []ResultAssertion
+	Variables          []ResultVariable
+	Scripts            []ResultScript
+}
+
+type ResultAssertion struct {
+	Source      string
+	Property    string
+	Comparison  string
+	TargetValue string
+	ActualValue string
+	Result      string
+	Error       string
+}
+
+type ResultVariable struct {
+	Name     string
+	Source   string
+	Property string
+	Value    string
+	Result   string
+	Error    string
+}
+
+type ResultScript struct {
+	Output string
+	Result string
+	Error  string
+}
+
+type ResultClient struct {
+	client *Client
+}
+
+func ResultFromSchema(s *schema.Result) *Result {
+	result := &Result{}
+	result.TestRunId = s.TestRunId
+	result.TestRunURL = s.TestRunURL
+	result.TestId = s.TestId
+	result.BucketId = s.BucketKey
+	result.EnvironmentId = s.EnvironmentId
+	result.EnvironmentName = s.EnvironmentName
+	result.Region = s.Region
+	result.Result = s.Result
+	result.StartedAt = unixTime(s.StartedAt)
+	result.FinishedAt = unixTime(s.FinishedAt)
+	result.AssertionsDefined = s.AssertionsDefined
+	result.AssertionsPassed = s.AssertionsPassed
+	result.AssertionsFailed = s.AssertionsFailed
+	result.RequestsExecuted = s.RequestsExecuted
+	result.Requests = make([]ResultRequest, len(s.Requests))
+	for i, r := range s.Requests {
+		result.Requests[i] = resultRequestFromSchema(&r)
+	}
+	return result
+}
+
+func resultRequestFromSchema(s *schema.ResultRequest) ResultRequest {
+	request := ResultRequest{
+		StepId:            s.UUID,
+		StepType:          s.StepType,
+		Method:            s.Method,
+		URL:               s.URL,
+		Result:            s.Result,
+		ResponseTime:      time.Duration(s.ResponseTimeMs) * time.Millisecond,
+		ResponseSizeBytes: s.ResponseSizeBytes,
+	}
+	request.ResponseStatusCode, _ = strconv.Atoi(resultValue(s.ResponseStatusCode))
+
+	for _, a := range s.Assertions {
+		request.Assertions = append(request.Assertions, ResultAssertion{
+			Source:      a.Source,
+			Property:    a.Property,
+			Comparison:  a.Comparison,
+			TargetValue: resultValue(a.TargetValue),
+			ActualValue: resultValue(a.ActualValue),
+			Result:      a.Result,
+			Error:       a.Error,
+		})
+	}
+	for _, v := range s.Variables {
+		request.Variables = append(request.Variables, ResultVariable{
+			Name:     v.Name,
+			Source:   v.Source,
+			Property: v.Property,
+			Value:    resultValue(v.Value),
+			Result:   v.Result,
+			Error:    v.Error,
+		})
+	}
+	for _, sc := range s.Scripts {
+		request.Scripts = append(request.Scripts, ResultScript{
+			Output: sc.Output,
+			Result: sc.Result,
+			Error:  sc.Error,
+		})
+	}
+	return request
+}
+
+// resultValue formats a value of a result, which the API reports as
+// either a string, a number or null, as a string.
+func resultValue(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(data)
+	}
+}
+
+// unixTime converts the fractional Unix timestamps used by results, where
+// zero means the time isn't known yet. The API reports them with
+// millisecond precision.
+func unixTime(ts float64) time.Time {
+	if ts == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, int64(math.Round(ts*1e3))*int64(time.Millisecond))
+}
+
+type ResultURLOpts struct {
+	BucketId string
+	TestId   string
+}
+
+func (opts *ResultURLOpts) URL() string {
+	return fmt.Sprintf("/buckets/%s/tests/%s/results", opts.BucketId, opts.TestId)
+}
+
+type ResultListOpts struct {
+	ResultURLOpts
+	// Count is the number of results to return, most recent first. The
+	// API defaults to 10 and returns at most 50.
+	Count int
+	// Before only returns results of runs started before this time, to
+	// page through older results.
+	Before time.Time
+}
+
+func (opts *ResultListOpts) URL() string {
+	params := url.Values{}
+	if opts.Count > 0 {
+		params.Set("count", strconv.Itoa(opts.Count))
+	}
+	if !opts.Before.IsZero() {
+		params.Set("before", strconv.FormatFloat(float64(opts.Before.UnixNano())/float64(time.Second), 'f', 3, 64))
+	}
+
+	if len(params) == 0 {
+		return opts.ResultURLOpts.URL()
+	}
+	return fmt.Sprintf("%s?%s", opts.ResultURLOpts.URL(), params.Encode())
+}
+
+func (c *ResultClient) List(ctx context.Context, opts *ResultListOpts) ([]*Result, error) {
+	req, err := c.client.NewRequest(ctx, http.MethodGet, opts.URL(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp schema.ResultListResponse
+	err = c.client.Do(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*Result, len(resp.Results))
+	for i, r := range resp.Results {
+		results[i] = ResultFromSchema(&r)
+	}
+	return results, nil
+}
+
+// Latest returns the result of the most recent run of a test.
+func (c *ResultClient) Latest(ctx context.Context, opts *ResultURLOpts) (*Result, error) {
+	return c.Get(ctx, &ResultGetOpts{ResultURLOpts: *opts, Id: "latest"})
+}
+
+type ResultGetOpts struct {
+	ResultURLOpts
+	Id string
+}
+
+func (opts *ResultGetOpts) URL() string {
+	return fmt.Sprintf("%s/%s", opts.ResultURLOpts.URL(), opts.Id)
+}
+
+func (c *ResultClient) Get(ctx context.Context, opts *ResultGetOpts) (*Result, error) {
+	req, err := c.client.NewRequest(ctx, http.MethodGet, opts.URL(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp schema.ResultGetResponse
+	err = c.client.Do(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return ResultFromSchema(&resp.Result), nil
+}
@@ -0,0 +1,124 @@
+package runscope
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestResultClient(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.RequestURI())
+		if r.URL.Path == "/buckets/bucket/tests/test/results" {
+			w.Write([]byte(`{"data": [` + resultTestResponse + `]}`))
+			return
+		}
+		w.Write([]byte(`{"data": ` + resultTestResponse + `}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(WithEndpoint(server.URL), WithMaxRetries(0))
+	ctx := context.Background()
+	opts := ResultURLOpts{BucketId: "bucket", TestId: "test"}
+
+	results, err := client.Result.List(ctx, &ResultListOpts{ResultURLOpts: opts, Count: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 1 {
+		t.Fatalf("expected 1 result, got %d", len(results))
+	}
+
+	latest, err := client.Result.Latest(ctx, &opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedPaths := []string{
+		"/buckets/bucket/tests/test/results?count=5",
+		"/buckets/bucket/tests/test/results/latest",
+	}
+	for i, path := range expectedPaths {
+		if i >= len(paths) || paths[i] != path {
+			t.Errorf("expected request %d to %s, got %v", i, path, paths)
+		}
+	}
+
+	if !latest.Done() || latest.Result != ResultFail {
+		t.Errorf("expected a finished failed result, got %s", latest.Result)
+	}
+	if expected := time.Unix(1652101243, 511000000); !latest.StartedAt.Equal(expected) {
+		t.Errorf("expected started at %s, got %s", expected, latest.StartedAt)
+	}
+	if len(latest.Requests) != 1 {
+		t.Fatalf("expected 1 request, got %d", len(latest.Requests))
+	}
+
+	request := latest.Requests[0]
+	if request.ResponseStatusCode != 200 || request.ResponseTime != 121*time.Millisecond {
+		t.Errorf("unexpected response %d in %s", request.ResponseStatusCode, request.ResponseTime)
+	}
+
+	expectedAssertions := []ResultAssertion{
+		{Source: "response_status", Comparison: "equal_number", TargetValue: "200", ActualValue: "200", Result: "pass"},
+		{Source: "response_json", Property: "role", Comparison: "equal", TargetValue: "admin", ActualValue: "guest", Result: "fail"},
+	}
+	if len(request.Assertions) != len(expectedAssertions) {
+		t.Fatalf("expected %d assertions, got %d", len(expectedAssertions), len(request.Assertions))
+	}
+	for i, expected := range expectedAssertions {
+		if request.Assertions[i] != expected {
+			t.Errorf("expected assertion %+v, got %+v", expected, request.Assertions[i])
+		}
+	}
+
+	if len(request.Variables) != 1 || request.Variables[0].Value != "42" {
+		t.Errorf("unexpected variables %+v", request.Variables)
+	}
+}
+
+const resultTestResponse = `{
+  "assertions_defined": 2,
+  "assertions_failed": 1,
+  "assertions_passed": 1,
+  "bucket_key": "bucket",
+  "finished_at": 1652101243.948,
+  "requests": [
+    {
+      "assertions": [
+        {"actual_value": 200, "comparison": "equal_number", "error": null, "property": null, "result": "pass", "source": "response_status", "target_value": "200"},
+        {"actual_value": "guest", "comparison": "equal", "error": null, "property": "role", "result": "fail", "source": "response_json", "target_value": "admin"}
+      ],
+      "method": "GET",
+      "response_status_code": "200",
+      "response_time_ms": 121,
+      "result": "fail",
+      "scripts": [],
+      "url": "https://example.com/users/42",
+      "uuid": "d7363d46-2c07-42db-bd2e-54b37e0094cc",
+      "variables": [
+        {"error": null, "name": "user_id", "property": "id", "result": "pass", "source": "response_json", "value": 42}
+      ]
+    }
+  ],
+  "result": "fail",
+  "started_at": 1652101243.511,
+  "test_id": "test",
+  "test_run_id": "0aa48464-f89e-4596-8d60-79bc678d36f4"
+}`
+
+func TestResultListOpts_URL(t *testing.T) {
+	opts := ResultListOpts{ResultURLOpts: ResultURLOpts{BucketId: "bucket", TestId: "test"}}
+	if url := opts.URL(); url != "/buckets/bucket/tests/test/results" {
+		t.Errorf("unexpected URL %s", url)
+	}
+
+	opts.Count = 50
+	opts.Before = time.Unix(1652101243, 511000000)
+	if url := opts.URL(); url != "/buckets/bucket/tests/test/results?before=1652101243.511&count=50" {
+		t.Errorf("unexpected URL %s", url)
+	}
+}
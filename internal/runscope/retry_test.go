@@ -0,0 +1,213 @@
+package runscope
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newRetryTestClient(url string) *Client {
+	return NewClient(
+		WithEndpoint(url),
+		WithMaxRetries(3),
+		WithRetryWait(time.Millisecond, 5*time.Millisecond),
+	)
+}
+
+func TestClient_Do_RetriesServerErrors(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Write([]byte(`{"data": {"name": "ok"}}`))
+	}))
+	defer server.Close()
+
+	client := newRetryTestClient(server.URL)
+	req, err := client.NewRequest(context.Background(), http.MethodGet, "/account", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var resp struct {
+		Data struct {
+			Name string `json:"name"`
+		} `json:"data"`
+	}
+	if err := client.Do(req, &resp); err != nil {
+		t.Fatalf("expected success after retries, got %s", err)
+	}
+	if calls != 3 {
+		t.Errorf("expected 3 calls, got %d", calls)
+	}
+	if resp.Data.Name != "ok" {
+		t.Errorf("expected name 'ok', got '%s'", resp.Data.Name)
+	}
+}
+
+func TestClient_Do_DoesNotRetryPostOnServerError(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client := newRetryTestClient(server.URL)
+	req, err := client.NewRequest(context.Background(), http.MethodPost, "/buckets", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.Do(req, nil); err == nil {
+		t.Fatal("expected error")
+	}
+	if calls != 1 {
+		t.Errorf("expected 1 call, got %d", calls)
+	}
+}
+
+func TestClient_Do_RetriesRateLimitedPostWithBody(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"name":"test"}` {
+			t.Errorf("unexpected body on attempt %d: %s", calls+1, body)
+		}
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := newRetryTestClient(server.URL)
+	req, err := client.NewRequest(context.Background(), http.MethodPost, "/buckets", map[string]string{"name": "test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.Do(req, nil); err != nil {
+		t.Fatalf("expected success after retry, got %s", err)
+	}
+	if calls != 2 {
+		t.Errorf("expected 2 calls, got %d", calls)
+	}
+}
+
+func TestClient_Do_GivesUpAfterMaxRetries(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := newRetryTestClient(server.URL)
+	req, err := client.NewRequest(context.Background(), http.MethodGet, "/account", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.Do(req, nil); err == nil {
+		t.Fatal("expected error")
+	}
+	if calls != 4 {
+		t.Errorf("expected 4 calls, got %d", calls)
+	}
+}
+
+func TestClient_Do_RespectsContextDeadline(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	client := newRetryTestClient(server.URL)
+	req, err := client.NewRequest(ctx, http.MethodGet, "/account", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Now()
+	if err := client.Do(req, nil); err == nil {
+		t.Fatal("expected error")
+	}
+	if time.Since(start) > 500*time.Millisecond {
+		t.Errorf("expected to give up without waiting for Retry-After")
+	}
+	if calls != 1 {
+		t.Errorf("expected 1 call, got %d", calls)
+	}
+}
+
+func TestClient_Do_DoesNotWaitLongerThanRetryWaitMax(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.Header().Set("Retry-After", "3600")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := newRetryTestClient(server.URL)
+	req, err := client.NewRequest(context.Background(), http.MethodGet, "/account", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Now()
+	err = client.Do(req, nil)
+	if err == nil || !strings.Contains(err.Error(), "maximum retry wait") {
+		t.Fatalf("expected an error about the retry wait, got %v", err)
+	}
+	if !errors.Is(err, ErrRateLimited) {
+		t.Errorf("expected a rate limited error, got %s", err)
+	}
+	if time.Since(start) > 500*time.Millisecond {
+		t.Errorf("expected to give up without waiting for Retry-After")
+	}
+	if calls != 1 {
+		t.Errorf("expected 1 call, got %d", calls)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2022, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		value    string
+		expected time.Duration
+		ok       bool
+	}{
+		{"", 0, false},
+		{"5", 5 * time.Second, true},
+		{"-1", 0, false},
+		{"Sun, 01 May 2022 12:00:30 GMT", 30 * time.Second, true},
+		{"Sun, 01 May 2022 11:00:00 GMT", 0, true},
+		{"soon", 0, false},
+	}
+
+	for _, test := range tests {
+		wait, ok := parseRetryAfter(test.value, now)
+		if ok != test.ok || wait != test.expected {
+			t.Errorf("parseRetryAfter(%q) = %s, %t; expected %s, %t", test.value, wait, ok, test.expected, test.ok)
+		}
+	}
+}
@@ -0,0 +1,932 @@
+// Package runscopetest provides an in-memory fake of the Runscope API for
+// tests that can't reach the real service.
+package runscopetest
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope/schema"
+)
+
+// Server is a fake Runscope API backed by httptest.Server. It keeps all
+// state in memory and answers with the same JSON shapes as the real API.
+type Server struct {
+	*httptest.Server
+
+	Token   string
+	Account schema.Account
+
+	// StepCreated, when set, is called after a step is appended to a test
+	// and before the step list is rendered into the response.
+	StepCreated func(bucketKey, testId, stepId string)
+
+	// RunResult, when set, decides whether a triggered run passes or
+	// fails. Runs pass when it is nil.
+	RunResult func(bucketKey, testId string, variables map[string]string) string
+
+	// RedactSecrets masks step auth passwords and environment client
+	// certificates in responses, the way the API may hide secrets.
+	RedactSecrets bool
+
+	mu           sync.Mutex
+	buckets      map[string]*bucket
+	bucketOrder  []string
+	integrations []schema.Integration
+	agents       []schema.RemoteAgent
+}
+
+type bucket struct {
+	schema.Bucket
+	tests        map[string]*test
+	testOrder    []string
+	environments map[string]document
+}
+
+type test struct {
+	document
+	steps        []document
+	environments map[string]document
+	schedules    map[string]*schema.Schedule
+	results      []*run
+}
+
+// run is a triggered test run. Its result goes from queued through working
+// to the final result as it is polled, like a run on the real API would.
+type run struct {
+	document
+	final    string
+	requests []document
+}
+
+func (r *run) poll() document {
+	switch r.document["result"] {
+	case "queued":
+		r.document["result"] = "working"
+		r.document["started_at"] = float64(time.Now().UnixNano()) / float64(time.Second)
+	case "working":
+		assertions := 0
+		for _, request := range r.requests {
+			request["result"] = r.final
+			for _, assertion := range request["assertions"].([]document) {
+				assertion["result"] = r.final
+				assertions++
+			}
+		}
+		r.document["assertions_defined"] = assertions
+		if r.final == "pass" {
+			r.document["assertions_passed"] = assertions
+		} else {
+			r.document["assertions_failed"] = assertions
+		}
+		r.document["result"] = r.final
+		r.document["requests"] = r.requests
+		r.document["requests_executed"] = len(r.requests)
+		r.document["finished_at"] = float64(time.Now().UnixNano()) / float64(time.Second)
+	}
+	return r.document
+}
+
+// document is a JSON object as stored by the fake. Keeping the raw object
+// rather than a schema type lets fields unknown to the provider survive
+// updates, the same way they do against the real API.
+type document map[string]interface{}
+
+func (d document) id() string {
+	id, _ := d["id"].(string)
+	return id
+}
+
+func (d document) merge(o document) {
+	for k, v := range o {
+		d[k] = v
+	}
+}
+
+// replace swaps the fields of d for those of o like a PUT to the API does,
+// dropping fields o leaves out. The read-only fields in keep stay as they
+// are, whatever o holds.
+func (d document) replace(o document, keep ...string) {
+	kept := document{}
+	for _, k := range keep {
+		if v, ok := d[k]; ok {
+			kept[k] = v
+		}
+	}
+	for k := range d {
+		delete(d, k)
+	}
+	d.merge(o)
+	d.merge(kept)
+}
+
+// NewServer starts a fake Runscope API with a single account and team.
+// The caller should call Close when finished.
+func NewServer() *Server {
+	s := &Server{
+		Token: newUUID(),
+		Account: schema.Account{
+			Name:  "Grace Hopper",
+			UUID:  newUUID(),
+			Email: "grace@example.com",
+			Teams: []schema.AccountTeam{{
+				Name: "Home",
+				UUID: newUUID(),
+			}},
+		},
+		buckets: map[string]*bucket{},
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// TeamID returns the UUID of the account's team.
+func (s *Server) TeamID() string {
+	return s.Account.Teams[0].UUID
+}
+
+// AddIntegration registers an integration for the account's team.
+func (s *Server) AddIntegration(integration schema.Integration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if integration.UUID == "" {
+		integration.UUID = newUUID()
+	}
+	s.integrations = append(s.integrations, integration)
+}
+
+// AddRemoteAgent registers a connected remote agent for the account's team.
+func (s *Server) AddRemoteAgent(agent schema.RemoteAgent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if agent.Id == "" {
+		agent.Id = newUUID()
+	}
+	s.agents = append(s.agents, agent)
+}
+
+type apiError struct {
+	status  int
+	message string
+}
+
+func (e *apiError) Error() string {
+	return e.message
+}
+
+func errorf(status int, format string, a ...interface{}) *apiError {
+	return &apiError{status: status, message: fmt.Sprintf(format, a...)}
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != "Bearer "+s.Token {
+		writeError(w, errorf(http.StatusUnauthorized,
+			"You must provide a valid Authorization header to use the Runscope API."))
+		return
+	}
+
+	var body document
+	if r.Body != nil {
+		data, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			writeError(w, errorf(http.StatusBadRequest, "couldn't read body: %s", err))
+			return
+		}
+		data = bytes.TrimSpace(data)
+		if len(data) > 0 && data[0] == '[' {
+			// Only reordering steps takes a list; pass it along with
+			// the request rather than through every route.
+			var list []document
+			if err := json.Unmarshal(data, &list); err != nil {
+				writeError(w, errorf(http.StatusBadRequest, "invalid JSON: %s", err))
+				return
+			}
+			r = r.WithContext(context.WithValue(r.Context(), listBodyKey{}, list))
+		} else if len(data) > 0 {
+			if err := json.Unmarshal(data, &body); err != nil {
+				writeError(w, errorf(http.StatusBadRequest, "invalid JSON: %s", err))
+				return
+			}
+		}
+	}
+
+	s.mu.Lock()
+	data, status, err := s.route(r, strings.Split(strings.Trim(r.URL.Path, "/"), "/"), body)
+	if err == nil && s.RedactSecrets {
+		data = redactSecrets(data)
+	}
+	s.mu.Unlock()
+
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeData(w, status, data)
+}
+
+// redactSecrets returns a copy of data with secrets masked.
+func redactSecrets(data interface{}) interface{} {
+	var redacted interface{}
+	raw, _ := json.Marshal(data)
+	json.Unmarshal(raw, &redacted)
+
+	var walk func(v interface{})
+	walk = func(v interface{}) {
+		switch v := v.(type) {
+		case map[string]interface{}:
+			if auth, ok := v["auth"].(map[string]interface{}); ok {
+				if password, ok := auth["password"].(string); ok && password != "" {
+					auth["password"] = "********"
+				}
+			}
+			if cert, ok := v["client_certificate"].(string); ok && cert != "" {
+				v["client_certificate"] = "********"
+			}
+			for _, field := range v {
+				walk(field)
+			}
+		case []interface{}:
+			for _, item := range v {
+				walk(item)
+			}
+		}
+	}
+	walk(redacted)
+	return redacted
+}
+
+type listBodyKey struct{}
+
+// listBody returns the body of a request sending a JSON list.
+func listBody(r *http.Request) ([]document, bool) {
+	list, ok := r.Context().Value(listBodyKey{}).([]document)
+	return list, ok
+}
+
+func (s *Server) route(r *http.Request, path []string, body document) (interface{}, int, *apiError) {
+	switch {
+	case len(path) == 1 && path[0] == "account":
+		if r.Method == http.MethodGet {
+			return s.Account, http.StatusOK, nil
+		}
+	case len(path) == 3 && path[0] == "teams":
+		if path[1] != s.TeamID() {
+			return nil, 0, errorf(http.StatusNotFound, "team not found")
+		}
+		if r.Method != http.MethodGet {
+			break
+		}
+		switch path[2] {
+		case "integrations":
+			return append([]schema.Integration{}, s.integrations...), http.StatusOK, nil
+		case "agents":
+			return append([]schema.RemoteAgent{}, s.agents...), http.StatusOK, nil
+		}
+	case len(path) >= 1 && path[0] == "buckets":
+		return s.routeBuckets(r, path[1:], body)
+	case len(path) == 3 && path[0] == "radar" && path[2] == "trigger":
+		if r.Method == http.MethodGet || r.Method == http.MethodPost {
+			return s.trigger(r)
+		}
+	}
+
+	return nil, 0, errorf(http.StatusNotFound, "no route for %s %s", r.Method, r.URL.Path)
+}
+
+func (s *Server) routeBuckets(r *http.Request, path []string, body document) (interface{}, int, *apiError) {
+	if len(path) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			buckets := make([]schema.Bucket, len(s.bucketOrder))
+			for i, key := range s.bucketOrder {
+				buckets[i] = s.buckets[key].Bucket
+			}
+			return buckets, http.StatusOK, nil
+		case http.MethodPost:
+			return s.createBucket(r)
+		}
+		return nil, 0, errorf(http.StatusMethodNotAllowed, "method not allowed")
+	}
+
+	b, ok := s.buckets[path[0]]
+	if !ok {
+		return nil, 0, errorf(http.StatusNotFound, "bucket %s not found", path[0])
+	}
+
+	if len(path) == 1 {
+		switch r.Method {
+		case http.MethodGet:
+			return b.Bucket, http.StatusOK, nil
+		case http.MethodDelete:
+			delete(s.buckets, b.Key)
+			s.bucketOrder = removeString(s.bucketOrder, b.Key)
+			return nil, http.StatusNoContent, nil
+		}
+		return nil, 0, errorf(http.StatusMethodNotAllowed, "method not allowed")
+	}
+
+	switch path[1] {
+	case "environments":
+		return routeEnvironments(r, b.environments, path[2:], body)
+	case "tests":
+		return s.routeTests(r, b, path[2:], body)
+	}
+
+	return nil, 0, errorf(http.StatusNotFound, "no route for %s %s", r.Method, r.URL.Path)
+}
+
+func (s *Server) createBucket(r *http.Request) (interface{}, int, *apiError) {
+	name := r.URL.Query().Get("name")
+	teamId := r.URL.Query().Get("team_uuid")
+	if name == "" || teamId == "" {
+		return nil, 0, errorf(http.StatusBadRequest, "name and team_uuid are required")
+	}
+	if teamId != s.TeamID() {
+		return nil, 0, errorf(http.StatusForbidden, "team %s not accessible", teamId)
+	}
+
+	key := newBucketKey()
+	b := &bucket{
+		Bucket: schema.Bucket{
+			Key:  key,
+			Name: name,
+			Team: schema.BucketTeam{
+				Name: s.Account.Teams[0].Name,
+				ID:   teamId,
+			},
+			VerifySSL:  true,
+			TriggerURL: fmt.Sprintf("%s/radar/bucket/%s/trigger", s.URL, newUUID()),
+		},
+		tests:        map[string]*test{},
+		environments: map[string]document{},
+	}
+	s.buckets[key] = b
+	s.bucketOrder = append(s.bucketOrder, key)
+
+	return b.Bucket, http.StatusCreated, nil
+}
+
+func (s *Server) routeTests(r *http.Request, b *bucket, path []string, body document) (interface{}, int, *apiError) {
+	if len(path) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			count, offset := 10, 0
+			if c, err := strconv.Atoi(r.URL.Query().Get("count")); err == nil && c > 0 {
+				count = c
+			}
+			if o, err := strconv.Atoi(r.URL.Query().Get("offset")); err == nil && o > 0 {
+				offset = o
+			}
+
+			tests := []interface{}{}
+			for i := offset; i < len(b.testOrder) && len(tests) < count; i++ {
+				tests = append(tests, b.tests[b.testOrder[i]].render())
+			}
+			return tests, http.StatusOK, nil
+		case http.MethodPost:
+			t := s.newTest(b, body)
+			return t.render(), http.StatusCreated, nil
+		}
+		return nil, 0, errorf(http.StatusMethodNotAllowed, "method not allowed")
+	}
+
+	t, ok := b.tests[path[0]]
+	if !ok {
+		return nil, 0, errorf(http.StatusNotFound, "test %s not found", path[0])
+	}
+
+	if len(path) == 1 {
+		switch r.Method {
+		case http.MethodGet:
+			return t.render(), http.StatusOK, nil
+		case http.MethodPut:
+			t.replace(body, "id", "created_at", "created_by", "trigger_url")
+			return t.render(), http.StatusOK, nil
+		case http.MethodDelete:
+			delete(b.tests, t.id())
+			b.testOrder = removeString(b.testOrder, t.id())
+			return nil, http.StatusNoContent, nil
+		}
+		return nil, 0, errorf(http.StatusMethodNotAllowed, "method not allowed")
+	}
+
+	switch path[1] {
+	case "steps":
+		return s.routeSteps(r, b, t, path[2:], body)
+	case "environments":
+		return routeEnvironments(r, t.environments, path[2:], body)
+	case "schedules":
+		return routeSchedules(r, t, path[2:], body)
+	case "results":
+		return routeResults(r, t, path[2:])
+	}
+
+	return nil, 0, errorf(http.StatusNotFound, "no route for %s %s", r.Method, r.URL.Path)
+}
+
+func (s *Server) newTest(b *bucket, body document) *test {
+	now := time.Now().Unix()
+	id := newUUID()
+
+	// The API creates every test with a "Test Settings" environment that
+	// becomes the test's default environment.
+	env := newDocument(schema.Environment{})
+	env.merge(document{
+		"id":         newUUID(),
+		"name":       "Test Settings",
+		"verify_ssl": true,
+	})
+
+	t := &test{
+		document: newDocument(schema.Test{
+			TestBase: schema.TestBase{
+				DefaultEnvironmentId: env.id(),
+			},
+			Id:        id,
+			CreatedAt: now,
+			CreatedBy: schema.CreatedBy{
+				Id:    s.Account.UUID,
+				Name:  s.Account.Name,
+				Email: s.Account.Email,
+			},
+			TriggerURL: fmt.Sprintf("%s/radar/%s/trigger", s.URL, newUUID()),
+		}),
+		environments: map[string]document{env.id(): env},
+		schedules:    map[string]*schema.Schedule{},
+	}
+	t.merge(body)
+	t.document["id"] = id
+
+	b.tests[id] = t
+	b.testOrder = append(b.testOrder, id)
+	return t
+}
+
+// render returns the test as the API presents it, with steps inlined.
+func (t *test) render() document {
+	d := document{}
+	d.merge(t.document)
+	d["steps"] = append([]document{}, t.steps...)
+	return d
+}
+
+func (s *Server) routeSteps(r *http.Request, b *bucket, t *test, path []string, body document) (interface{}, int, *apiError) {
+	if len(path) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			return append([]document{}, t.steps...), http.StatusOK, nil
+		case http.MethodPost:
+			step := document{}
+			step.merge(body)
+			step["id"] = newUUID()
+			if _, ok := step["step_type"]; !ok {
+				step["step_type"] = "request"
+			}
+			if step["step_type"] == "incoming_request" {
+				step["capture_url"] = fmt.Sprintf("%s/capture/%s", s.URL, newUUID())
+			}
+			t.steps = append(t.steps, step)
+
+			if s.StepCreated != nil {
+				s.mu.Unlock()
+				s.StepCreated(b.Key, t.id(), step.id())
+				s.mu.Lock()
+			}
+
+			// Creating a step answers with every step of the test, not just
+			// the new one.
+			return append([]document{}, t.steps...), http.StatusCreated, nil
+		case http.MethodPut:
+			return reorderSteps(r, t)
+		}
+		return nil, 0, errorf(http.StatusMethodNotAllowed, "method not allowed")
+	}
+
+	i := -1
+	for j, step := range t.steps {
+		if step.id() == path[0] {
+			i = j
+			break
+		}
+	}
+	if i < 0 || len(path) > 1 {
+		return nil, 0, errorf(http.StatusNotFound, "step %s not found", path[0])
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		return t.steps[i], http.StatusOK, nil
+	case http.MethodPut:
+		// The capture URL is generated by the API and can't be changed.
+		t.steps[i].replace(body, "id", "step_type", "capture_url")
+		return t.steps[i], http.StatusOK, nil
+	case http.MethodDelete:
+		t.steps = append(t.steps[:i], t.steps[i+1:]...)
+		return nil, http.StatusNoContent, nil
+	}
+
+	return nil, 0, errorf(http.StatusMethodNotAllowed, "method not allowed")
+}
+
+// reorderSteps puts the steps of a test in the order of the list sent,
+// which has to hold every step of the test.
+func reorderSteps(r *http.Request, t *test) (interface{}, int, *apiError) {
+	list, ok := listBody(r)
+	if !ok {
+		return nil, 0, errorf(http.StatusBadRequest, "expected a list of steps")
+	}
+	if len(list) != len(t.steps) {
+		return nil, 0, errorf(http.StatusBadRequest, "expected all %d steps, got %d", len(t.steps), len(list))
+	}
+
+	steps := map[string]document{}
+	for _, step := range t.steps {
+		steps[step.id()] = step
+	}
+
+	reordered := make([]document, len(list))
+	for i, step := range list {
+		existing, ok := steps[step.id()]
+		if !ok {
+			return nil, 0, errorf(http.StatusBadRequest, "step %s not found or listed twice", step.id())
+		}
+		delete(steps, step.id())
+		reordered[i] = existing
+	}
+	t.steps = reordered
+
+	return append([]document{}, t.steps...), http.StatusOK, nil
+}
+
+func routeEnvironments(r *http.Request, envs map[string]document, path []string, body document) (interface{}, int, *apiError) {
+	if len(path) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			list := make([]document, 0, len(envs))
+			for _, env := range envs {
+				list = append(list, env)
+			}
+			sort.Slice(list, func(i, j int) bool {
+				return fmt.Sprint(list[i]["name"]) < fmt.Sprint(list[j]["name"])
+			})
+			return list, http.StatusOK, nil
+		case http.MethodPost:
+			env := newDocument(schema.Environment{})
+			env.merge(body)
+			env["id"] = newUUID()
+			envs[env.id()] = env
+			return env, http.StatusCreated, nil
+		}
+		return nil, 0, errorf(http.StatusMethodNotAllowed, "method not allowed")
+	}
+
+	env, ok := envs[path[0]]
+	if !ok || len(path) > 1 {
+		return nil, 0, errorf(http.StatusNotFound, "environment %s not found", path[0])
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		return env, http.StatusOK, nil
+	case http.MethodPut:
+		env.replace(body, "id")
+		return env, http.StatusOK, nil
+	case http.MethodDelete:
+		delete(envs, env.id())
+		return nil, http.StatusNoContent, nil
+	}
+
+	return nil, 0, errorf(http.StatusMethodNotAllowed, "method not allowed")
+}
+
+func routeSchedules(r *http.Request, t *test, path []string, body document) (interface{}, int, *apiError) {
+	if len(path) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			list := make([]schema.Schedule, 0, len(t.schedules))
+			for _, schedule := range t.schedules {
+				list = append(list, *schedule)
+			}
+			sort.Slice(list, func(i, j int) bool {
+				return list[i].Id < list[j].Id
+			})
+			return list, http.StatusOK, nil
+		case http.MethodPost:
+			schedule := &schema.Schedule{Id: newUUID()}
+			if err := setSchedule(schedule, body); err != nil {
+				return nil, 0, err
+			}
+			t.schedules[schedule.Id] = schedule
+			return schedule, http.StatusCreated, nil
+		}
+		return nil, 0, errorf(http.StatusMethodNotAllowed, "method not allowed")
+	}
+
+	schedule, ok := t.schedules[path[0]]
+	if !ok || len(path) > 1 {
+		return nil, 0, errorf(http.StatusNotFound, "schedule %s not found", path[0])
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		return schedule, http.StatusOK, nil
+	case http.MethodPut:
+		if err := setSchedule(schedule, body); err != nil {
+			return nil, 0, err
+		}
+		return schedule, http.StatusOK, nil
+	case http.MethodDelete:
+		delete(t.schedules, schedule.Id)
+		return nil, http.StatusNoContent, nil
+	}
+
+	return nil, 0, errorf(http.StatusMethodNotAllowed, "method not allowed")
+}
+
+func (s *Server) trigger(r *http.Request) (interface{}, int, *apiError) {
+	triggerURL := s.URL + r.URL.Path
+
+	var (
+		b *bucket
+		t *test
+	)
+	for _, key := range s.bucketOrder {
+		for _, id := range s.buckets[key].testOrder {
+			if s.buckets[key].tests[id].document["trigger_url"] == triggerURL {
+				b, t = s.buckets[key], s.buckets[key].tests[id]
+			}
+		}
+	}
+	if t == nil {
+		return nil, 0, errorf(http.StatusNotFound, "trigger %s not found", r.URL.Path)
+	}
+
+	variables := map[string]string{}
+	for k := range r.URL.Query() {
+		variables[k] = r.URL.Query().Get(k)
+	}
+
+	envId, _ := t.document["default_environment_id"].(string)
+	if id, ok := variables["runscope_environment"]; ok {
+		envId = id
+		delete(variables, "runscope_environment")
+	}
+	env, ok := t.environments[envId]
+	if !ok {
+		env, ok = b.environments[envId]
+	}
+	if !ok {
+		return nil, 0, errorf(http.StatusBadRequest, "environment %s not found", envId)
+	}
+
+	final := "pass"
+	if s.RunResult != nil {
+		s.mu.Unlock()
+		final = s.RunResult(b.Key, t.id(), variables)
+		s.mu.Lock()
+	}
+
+	id := newUUID()
+	runURL := fmt.Sprintf("%s/radar/%s/%s/results/%s", s.URL, b.Key, t.id(), id)
+	t.results = append(t.results, &run{
+		document: document{
+			"test_run_id":        id,
+			"test_run_url":       runURL,
+			"test_id":            t.id(),
+			"bucket_key":         b.Key,
+			"environment_id":     envId,
+			"environment_name":   env["name"],
+			"region":             "us1",
+			"result":             "queued",
+			"started_at":         nil,
+			"finished_at":        nil,
+			"requests_executed":  0,
+			"requests":           []document{},
+			"assertions_defined": 0,
+			"assertions_passed":  0,
+			"assertions_failed":  0,
+		},
+		final:    final,
+		requests: runRequests(t.steps),
+	})
+
+	return schema.TestTrigger{
+		Runs: []schema.TestRun{{
+			TestRunId:       id,
+			TestRunURL:      runURL,
+			TestId:          t.id(),
+			BucketKey:       b.Key,
+			EnvironmentId:   envId,
+			EnvironmentName: fmt.Sprint(env["name"]),
+			Region:          "us1",
+			Status:          "init",
+			Variables:       variables,
+		}},
+		RunsStarted: 1,
+		RunsTotal:   1,
+	}, http.StatusCreated, nil
+}
+
+// runRequests makes up the per step outcomes of a run of steps. Only
+// request steps are reported, with every assertion sharing the outcome of
+// the run.
+func runRequests(steps []document) []document {
+	requests := []document{}
+	for _, step := range steps {
+		if step["step_type"] != "request" {
+			continue
+		}
+
+		assertions := []document{}
+		if list, ok := step["assertions"].([]interface{}); ok {
+			for _, a := range list {
+				a, _ := a.(map[string]interface{})
+				assertions = append(assertions, document{
+					"source":       a["source"],
+					"property":     a["property"],
+					"comparison":   a["comparison"],
+					"target_value": a["value"],
+					"actual_value": a["value"],
+					"error":        nil,
+				})
+			}
+		}
+
+		requests = append(requests, document{
+			"uuid":                 step.id(),
+			"step_type":            "request",
+			"method":               step["method"],
+			"url":                  step["url"],
+			"response_status_code": "200",
+			"response_time_ms":     100,
+			"response_size_bytes":  0,
+			"assertions":           assertions,
+			"variables":            []document{},
+			"scripts":              []document{},
+		})
+	}
+	return requests
+}
+
+func routeResults(r *http.Request, t *test, path []string) (interface{}, int, *apiError) {
+	if r.Method != http.MethodGet {
+		return nil, 0, errorf(http.StatusMethodNotAllowed, "method not allowed")
+	}
+
+	if len(path) == 0 {
+		count := 10
+		if c, err := strconv.Atoi(r.URL.Query().Get("count")); err == nil && c > 0 {
+			count = c
+		}
+
+		before, _ := strconv.ParseFloat(r.URL.Query().Get("before"), 64)
+
+		// Results are listed most recent first.
+		list := []document{}
+		for i := len(t.results) - 1; i >= 0 && len(list) < count; i-- {
+			result := t.results[i].poll()
+			if startedAt, _ := result["started_at"].(float64); before > 0 && startedAt >= before {
+				continue
+			}
+			list = append(list, result)
+		}
+		return list, http.StatusOK, nil
+	}
+
+	if len(path) == 1 {
+		if path[0] == "latest" && len(t.results) > 0 {
+			return t.results[len(t.results)-1].poll(), http.StatusOK, nil
+		}
+		for _, result := range t.results {
+			if result.document["test_run_id"] == path[0] {
+				return result.poll(), http.StatusOK, nil
+			}
+		}
+	}
+
+	return nil, 0, errorf(http.StatusNotFound, "result %s not found", strings.Join(path, "/"))
+}
+
+// scheduleIntervals maps the intervals accepted by the API to the form it
+// reports them in.
+var scheduleIntervals = map[string]string{
+	"1m":  "1.0m",
+	"5m":  "5.0m",
+	"15m": "15.0m",
+	"30m": "30.0m",
+	"1h":  "1.0h",
+	"6h":  "6.0h",
+	"1d":  "1.0d",
+}
+
+func setSchedule(schedule *schema.Schedule, body document) *apiError {
+	var base schema.ScheduleBase
+	if err := decode(body, &base); err != nil {
+		return err
+	}
+
+	interval, ok := scheduleIntervals[base.Interval]
+	if !ok {
+		return errorf(http.StatusBadRequest, "invalid interval %q", base.Interval)
+	}
+	schedule.ScheduleBase = base
+	schedule.Interval = interval
+	return nil
+}
+
+func decode(body document, v interface{}) *apiError {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return errorf(http.StatusBadRequest, "invalid body: %s", err)
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return errorf(http.StatusBadRequest, "invalid body: %s", err)
+	}
+	return nil
+}
+
+func newDocument(v interface{}) document {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+
+	var d document
+	if err := json.Unmarshal(data, &d); err != nil {
+		panic(err)
+	}
+	return d
+}
+
+func writeData(w http.ResponseWriter, status int, data interface{}) {
+	if status == http.StatusNoContent {
+		w.WriteHeader(status)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"meta":  map[string]string{"status": "success"},
+		"data":  data,
+		"error": nil,
+	})
+}
+
+func writeError(w http.ResponseWriter, err *apiError) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(err.status)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"meta": map[string]string{"status": "error"},
+		"data": map[string]string{},
+		"error": map[string]interface{}{
+			"status":  err.status,
+			"message": err.message,
+		},
+	})
+}
+
+func removeString(s []string, v string) []string {
+	for i, e := range s {
+		if e == v {
+			return append(s[:i], s[i+1:]...)
+		}
+	}
+	return s
+}
+
+func newUUID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+func newBucketKey() string {
+	const alphabet = "abcdefghijklmnopqrstuvwxyz0123456789"
+	b := make([]byte, 12)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	for i := range b {
+		b[i] = alphabet[int(b[i])%len(alphabet)]
+	}
+	return string(b)
+}
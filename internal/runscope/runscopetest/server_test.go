@@ -0,0 +1,595 @@
+package runscopetest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope"
+)
+
+func newTestClient(s *Server) *runscope.Client {
+	return runscope.NewClient(runscope.WithEndpoint(s.URL), runscope.WithToken(s.Token), runscope.WithMaxRetries(0))
+}
+
+func TestServer_Unauthorized(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	client := runscope.NewClient(runscope.WithEndpoint(s.URL), runscope.WithToken("invalid"))
+	_, err := client.Account.Get(context.Background(), &runscope.AccountGetOpts{})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestServer_TestLifecycle(t *testing.T) {
+	ctx := context.Background()
+	s := NewServer()
+	defer s.Close()
+	client := newTestClient(s)
+
+	account, err := client.Account.Get(ctx, &runscope.AccountGetOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(account.Teams) != 1 || account.Teams[0].UUID != s.TeamID() {
+		t.Fatalf("unexpected teams %+v", account.Teams)
+	}
+
+	bucket, err := client.Bucket.Create(ctx, &runscope.BucketCreateOpts{Name: "bucket", TeamUUID: s.TeamID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	test, err := client.Test.Create(ctx, runscope.TestCreateOpts{
+		BucketId:    bucket.Key,
+		TestMinimal: runscope.TestMinimal{Name: "test"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if test.DefaultEnvironmentId == "" {
+		t.Error("expected a default environment to be created with the test")
+	}
+
+	var stepIds []string
+	for _, url := range []string{"https://a.example.com", "https://b.example.com"} {
+		opts := &runscope.StepCreateRequestOpts{}
+		opts.BucketId = bucket.Key
+		opts.TestId = test.Id
+		opts.Method = "GET"
+		opts.StepURL = url
+		step, err := client.Step.CreateRequest(ctx, opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if step.StepURL != url {
+			t.Errorf("expected step url %s, got %s", url, step.StepURL)
+		}
+		stepIds = append(stepIds, step.ID)
+	}
+
+	test, err = client.Test.Get(ctx, runscope.TestGetOpts{BucketId: bucket.Key, Id: test.Id})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(test.Steps) != 2 || test.Steps[0].Id != stepIds[0] || test.Steps[1].Id != stepIds[1] {
+		t.Errorf("unexpected steps %+v", test.Steps)
+	}
+
+	scheduleOpts := &runscope.ScheduleCreateOpts{}
+	scheduleOpts.BucketId = bucket.Key
+	scheduleOpts.TestId = test.Id
+	scheduleOpts.EnvironmentId = test.DefaultEnvironmentId
+	scheduleOpts.Interval = "1h"
+	schedule, err := client.Schedule.Create(ctx, scheduleOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if schedule.Interval != "1.0h" {
+		t.Errorf("expected interval to be reported as 1.0h, got %s", schedule.Interval)
+	}
+
+	envOpts := &runscope.EnvironmentCreateOpts{}
+	envOpts.BucketId = bucket.Key
+	envOpts.Name = "shared"
+	envOpts.InitialVariables = map[string]string{"base_url": "https://example.com"}
+	env, err := client.Environment.Create(ctx, envOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	getOpts := &runscope.EnvironmentGetOpts{Id: env.Id}
+	getOpts.BucketId = bucket.Key
+	env, err = client.Environment.Get(ctx, getOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if env.InitialVariables["base_url"] != "https://example.com" {
+		t.Errorf("unexpected initial variables %+v", env.InitialVariables)
+	}
+
+	if err := client.Bucket.Delete(ctx, &runscope.BucketDeleteOpts{BucketGetOpts: runscope.BucketGetOpts{Key: bucket.Key}}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.Bucket.Get(ctx, &runscope.BucketGetOpts{Key: bucket.Key})
+	var runscopeErr runscope.Error
+	if !errors.As(err, &runscopeErr) || runscopeErr.Status() != 404 {
+		t.Errorf("expected 404 after delete, got %v", err)
+	}
+}
+
+func TestServer_IncomingRequestStep(t *testing.T) {
+	ctx := context.Background()
+	s := NewServer()
+	defer s.Close()
+	client := newTestClient(s)
+
+	bucket, err := client.Bucket.Create(ctx, &runscope.BucketCreateOpts{Name: "bucket", TeamUUID: s.TeamID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	test, err := client.Test.Create(ctx, runscope.TestCreateOpts{
+		BucketId:    bucket.Key,
+		TestMinimal: runscope.TestMinimal{Name: "test"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	opts := &runscope.StepCreateIncomingRequestOpts{}
+	opts.BucketId = bucket.Key
+	opts.TestId = test.Id
+	opts.Note = "webhook"
+	step, err := client.Step.CreateIncomingRequest(ctx, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if step.CaptureURL == "" {
+		t.Fatal("expected a capture url to be generated")
+	}
+
+	updateOpts := &runscope.StepUpdateIncomingRequestOpts{}
+	updateOpts.BucketId = bucket.Key
+	updateOpts.TestId = test.Id
+	updateOpts.Id = step.ID
+	updateOpts.Note = "callback"
+	updated, err := client.Step.UpdateIncomingRequest(ctx, updateOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Note != "callback" {
+		t.Errorf("expected note callback, got %s", updated.Note)
+	}
+	if updated.CaptureURL != step.CaptureURL {
+		t.Errorf("expected capture url %s to be kept, got %s", step.CaptureURL, updated.CaptureURL)
+	}
+}
+
+func TestServer_ReorderSteps(t *testing.T) {
+	ctx := context.Background()
+	s := NewServer()
+	defer s.Close()
+	client := newTestClient(s)
+
+	bucket, err := client.Bucket.Create(ctx, &runscope.BucketCreateOpts{Name: "bucket", TeamUUID: s.TeamID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	test, err := client.Test.Create(ctx, runscope.TestCreateOpts{
+		BucketId:    bucket.Key,
+		TestMinimal: runscope.TestMinimal{Name: "test"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var ids []string
+	for i := 0; i < 3; i++ {
+		opts := &runscope.StepCreateRequestOpts{}
+		opts.BucketId = bucket.Key
+		opts.TestId = test.Id
+		opts.Method = "GET"
+		opts.StepURL = fmt.Sprintf("https://%d.example.com", i)
+		step, err := client.Step.CreateRequest(ctx, opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, step.ID)
+	}
+
+	opts := &runscope.StepReorderOpts{StepIds: []string{ids[2], ids[0]}}
+	opts.BucketId = bucket.Key
+	opts.TestId = test.Id
+	reordered, err := client.Step.Reorder(ctx, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{ids[2], ids[0], ids[1]}
+	if fmt.Sprint(reordered) != fmt.Sprint(expected) {
+		t.Errorf("expected steps %v, got %v", expected, reordered)
+	}
+
+	got, err := client.Test.Get(ctx, runscope.TestGetOpts{BucketId: bucket.Key, Id: test.Id})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var actual []string
+	for _, step := range got.Steps {
+		actual = append(actual, step.Id)
+	}
+	if fmt.Sprint(actual) != fmt.Sprint(expected) {
+		t.Errorf("expected test steps %v, got %v", expected, actual)
+	}
+
+	opts.StepIds = []string{"unknown"}
+	if _, err := client.Step.Reorder(ctx, opts); err == nil {
+		t.Error("expected reordering an unknown step to fail")
+	}
+
+	opts.StepIds = []string{ids[0], ids[0]}
+	if _, err := client.Step.Reorder(ctx, opts); err == nil {
+		t.Error("expected listing a step twice to fail")
+	}
+}
+
+func TestServer_UpdateKeepsUnknownFields(t *testing.T) {
+	ctx := context.Background()
+	s := NewServer()
+	defer s.Close()
+	client := newTestClient(s)
+
+	bucket, err := client.Bucket.Create(ctx, &runscope.BucketCreateOpts{Name: "bucket", TeamUUID: s.TeamID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Create each resource with a field the client doesn't know about, as
+	// if it was set in the UI.
+	create := func(path string, body map[string]interface{}) string {
+		body["unmodeled"] = "keep me"
+		req, err := client.NewRequest(ctx, http.MethodPost, path, body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var resp struct {
+			Data json.RawMessage `json:"data"`
+		}
+		if err := client.Do(req, &resp); err != nil {
+			t.Fatal(err)
+		}
+		var created struct {
+			Id string `json:"id"`
+		}
+		var steps []struct {
+			Id string `json:"id"`
+		}
+		if err := json.Unmarshal(resp.Data, &steps); err == nil {
+			return steps[len(steps)-1].Id
+		}
+		if err := json.Unmarshal(resp.Data, &created); err != nil {
+			t.Fatal(err)
+		}
+		return created.Id
+	}
+	unmodeled := func(path string) interface{} {
+		req, err := client.NewRequest(ctx, http.MethodGet, path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var resp struct {
+			Data map[string]interface{} `json:"data"`
+		}
+		if err := client.Do(req, &resp); err != nil {
+			t.Fatal(err)
+		}
+		return resp.Data["unmodeled"]
+	}
+
+	testId := create(fmt.Sprintf("/buckets/%s/tests", bucket.Key), map[string]interface{}{"name": "test"})
+	testPath := fmt.Sprintf("/buckets/%s/tests/%s", bucket.Key, testId)
+
+	testOpts := runscope.TestUpdateOpts{BucketId: bucket.Key}
+	testOpts.Id = testId
+	testOpts.Name = "renamed"
+	test, err := client.Test.Update(ctx, testOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if test.Name != "renamed" {
+		t.Errorf("expected test to be renamed, got %s", test.Name)
+	}
+	if v := unmodeled(testPath); v != "keep me" {
+		t.Errorf("expected unknown test field to be kept, got %v", v)
+	}
+
+	envId := create(testPath+"/environments", map[string]interface{}{"name": "env"})
+	envOpts := &runscope.EnvironmentUpdateOpts{}
+	envOpts.BucketId = bucket.Key
+	envOpts.TestId = testId
+	envOpts.Id = envId
+	envOpts.Name = "renamed"
+	env, err := client.Environment.Update(ctx, envOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if env.Name != "renamed" {
+		t.Errorf("expected environment to be renamed, got %s", env.Name)
+	}
+	if v := unmodeled(envOpts.URL()); v != "keep me" {
+		t.Errorf("expected unknown environment field to be kept, got %v", v)
+	}
+
+	stepId := create(testPath+"/steps", map[string]interface{}{"step_type": "request", "method": "GET"})
+	stepOpts := &runscope.StepUpdateRequestOpts{}
+	stepOpts.BucketId = bucket.Key
+	stepOpts.TestId = testId
+	stepOpts.Id = stepId
+	stepOpts.Method = "POST"
+	step, err := client.Step.UpdateRequest(ctx, stepOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if step.Method != "POST" {
+		t.Errorf("expected step method POST, got %s", step.Method)
+	}
+	if v := unmodeled(stepOpts.URL()); v != "keep me" {
+		t.Errorf("expected unknown step field to be kept, got %v", v)
+	}
+
+	pauseId := create(testPath+"/steps", map[string]interface{}{"step_type": "pause", "duration": 1})
+	pauseOpts := &runscope.StepUpdatePauseOpts{}
+	pauseOpts.BucketId = bucket.Key
+	pauseOpts.TestId = testId
+	pauseOpts.Id = pauseId
+	pauseOpts.Duration = 5
+	pause, err := client.Step.UpdatePause(ctx, pauseOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pause.Duration != 5 {
+		t.Errorf("expected pause duration 5, got %d", pause.Duration)
+	}
+	if v := unmodeled(pauseOpts.URL()); v != "keep me" {
+		t.Errorf("expected unknown pause step field to be kept, got %v", v)
+	}
+
+	conditionId := create(testPath+"/steps", map[string]interface{}{"step_type": "condition", "left_value": "1", "comparison": "equal", "right_value": "1"})
+	conditionOpts := &runscope.StepUpdateConditionOpts{}
+	conditionOpts.BucketId = bucket.Key
+	conditionOpts.TestId = testId
+	conditionOpts.Id = conditionId
+	conditionOpts.LeftValue = "1"
+	conditionOpts.Comparison = "not_equal"
+	conditionOpts.RightValue = "2"
+	condition, err := client.Step.UpdateCondition(ctx, conditionOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if condition.Comparison != "not_equal" {
+		t.Errorf("expected condition comparison not_equal, got %s", condition.Comparison)
+	}
+	if v := unmodeled(conditionOpts.URL()); v != "keep me" {
+		t.Errorf("expected unknown condition step field to be kept, got %v", v)
+	}
+
+	incomingId := create(testPath+"/steps", map[string]interface{}{"step_type": "incoming_request"})
+	incomingOpts := &runscope.StepUpdateIncomingRequestOpts{}
+	incomingOpts.BucketId = bucket.Key
+	incomingOpts.TestId = testId
+	incomingOpts.Id = incomingId
+	incomingOpts.Note = "webhook"
+	incoming, err := client.Step.UpdateIncomingRequest(ctx, incomingOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if incoming.Note != "webhook" {
+		t.Errorf("expected incoming request note webhook, got %s", incoming.Note)
+	}
+	if incoming.CaptureURL == "" {
+		t.Error("expected incoming request capture URL to be kept")
+	}
+	if v := unmodeled(incomingOpts.URL()); v != "keep me" {
+		t.Errorf("expected unknown incoming request step field to be kept, got %v", v)
+	}
+}
+
+func TestServer_UpdateClearsFields(t *testing.T) {
+	ctx := context.Background()
+	s := NewServer()
+	defer s.Close()
+	client := newTestClient(s)
+
+	bucket, err := client.Bucket.Create(ctx, &runscope.BucketCreateOpts{Name: "bucket", TeamUUID: s.TeamID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parentOpts := &runscope.EnvironmentCreateOpts{}
+	parentOpts.BucketId = bucket.Key
+	parentOpts.Name = "parent"
+	parent, err := client.Environment.Create(ctx, parentOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	childOpts := &runscope.EnvironmentCreateOpts{}
+	childOpts.BucketId = bucket.Key
+	childOpts.Name = "child"
+	childOpts.ParentEnvironmentId = parent.Id
+	child, err := client.Environment.Create(ctx, childOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if child.ParentEnvironmentId != parent.Id {
+		t.Fatalf("expected parent %s, got %q", parent.Id, child.ParentEnvironmentId)
+	}
+
+	updateOpts := &runscope.EnvironmentUpdateOpts{}
+	updateOpts.BucketId = bucket.Key
+	updateOpts.Id = child.Id
+	updateOpts.Name = "child"
+	if _, err := client.Environment.Update(ctx, updateOpts); err != nil {
+		t.Fatal(err)
+	}
+
+	getOpts := &runscope.EnvironmentGetOpts{Id: child.Id}
+	getOpts.BucketId = bucket.Key
+	env, err := client.Environment.Get(ctx, getOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if env.ParentEnvironmentId != "" {
+		t.Errorf("expected parent to be cleared, got %q", env.ParentEnvironmentId)
+	}
+}
+
+func TestServer_Results(t *testing.T) {
+	ctx := context.Background()
+	s := NewServer()
+	defer s.Close()
+	client := newTestClient(s)
+
+	bucket, err := client.Bucket.Create(ctx, &runscope.BucketCreateOpts{Name: "bucket", TeamUUID: s.TeamID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	test, err := client.Test.Create(ctx, runscope.TestCreateOpts{
+		BucketId:    bucket.Key,
+		TestMinimal: runscope.TestMinimal{Name: "test"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stepOpts := &runscope.StepCreateRequestOpts{}
+	stepOpts.BucketId = bucket.Key
+	stepOpts.TestId = test.Id
+	stepOpts.Method = "GET"
+	stepOpts.StepURL = "https://example.com"
+	stepOpts.Assertions = []runscope.StepAssertion{{Source: "response_status", Comparison: "equal_number", Value: "200"}}
+	if _, err := client.Step.CreateRequest(ctx, stepOpts); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 3; i++ {
+		if _, err := client.Test.Trigger(ctx, runscope.TestTriggerOpts{TriggerURL: test.TriggerURL}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	opts := runscope.ResultURLOpts{BucketId: bucket.Key, TestId: test.Id}
+	results, err := client.Result.List(ctx, &runscope.ResultListOpts{ResultURLOpts: opts, Count: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(results))
+	}
+
+	var latest *runscope.Result
+	for i := 0; i < 3 && (latest == nil || !latest.Done()); i++ {
+		latest, err = client.Result.Latest(ctx, &opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if latest.TestRunId != results[0].TestRunId {
+		t.Errorf("expected latest result %s to be listed first, got %s", latest.TestRunId, results[0].TestRunId)
+	}
+	if latest.Result != runscope.ResultPass {
+		t.Fatalf("expected the run to pass, got %s", latest.Result)
+	}
+	if len(latest.Requests) != 1 || len(latest.Requests[0].Assertions) != 1 || latest.Requests[0].Assertions[0].Result != runscope.ResultPass {
+		t.Errorf("unexpected requests %+v", latest.Requests)
+	}
+}
+
+func TestServer_ListTests(t *testing.T) {
+	ctx := context.Background()
+	s := NewServer()
+	defer s.Close()
+	client := newTestClient(s)
+
+	bucket, err := client.Bucket.Create(ctx, &runscope.BucketCreateOpts{Name: "bucket", TeamUUID: s.TeamID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// More than fit on one page.
+	const n = 120
+	for i := 0; i < n; i++ {
+		_, err := client.Test.Create(ctx, runscope.TestCreateOpts{
+			BucketId:    bucket.Key,
+			TestMinimal: runscope.TestMinimal{Name: fmt.Sprintf("test %d", i)},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests, err := client.Test.List(ctx, runscope.TestListOpts{BucketId: bucket.Key})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tests) != n {
+		t.Fatalf("expected %d tests, got %d", n, len(tests))
+	}
+	for i, test := range tests {
+		if expected := fmt.Sprintf("test %d", i); test.Name != expected {
+			t.Errorf("expected test %d to be %s, got %s", i, expected, test.Name)
+		}
+	}
+}
+
+func TestServer_ListSchedules(t *testing.T) {
+	ctx := context.Background()
+	s := NewServer()
+	defer s.Close()
+	client := newTestClient(s)
+
+	bucket, err := client.Bucket.Create(ctx, &runscope.BucketCreateOpts{Name: "bucket", TeamUUID: s.TeamID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	test, err := client.Test.Create(ctx, runscope.TestCreateOpts{BucketId: bucket.Key})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ids := map[string]bool{}
+	for _, interval := range []string{"1h", "1d"} {
+		opts := &runscope.ScheduleCreateOpts{}
+		opts.BucketId = bucket.Key
+		opts.TestId = test.Id
+		opts.EnvironmentId = test.DefaultEnvironmentId
+		opts.Interval = interval
+		schedule, err := client.Schedule.Create(ctx, opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids[schedule.Id] = true
+	}
+
+	listOpts := &runscope.ScheduleListOpts{}
+	listOpts.BucketId = bucket.Key
+	listOpts.TestId = test.Id
+	schedules, err := client.Schedule.List(ctx, listOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(schedules) != 2 {
+		t.Fatalf("expected 2 schedules, got %d", len(schedules))
+	}
+	for _, schedule := range schedules {
+		if !ids[schedule.Id] {
+			t.Errorf("unexpected schedule %s", schedule.Id)
+		}
+	}
+}
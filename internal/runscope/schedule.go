@@ -41,6 +41,29 @@ func (opts *ScheduleURLOpts) URL() string {
 	return fmt.Sprintf("/buckets/%s/tests/%s/schedules", opts.BucketId, opts.TestId)
 }
 
+type ScheduleListOpts struct {
+	ScheduleURLOpts
+}
+
+func (c *ScheduleClient) List(ctx context.Context, opts *ScheduleListOpts) ([]*Schedule, error) {
+	req, err := c.client.NewRequest(ctx, http.MethodGet, opts.URL(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp schema.ScheduleListResponse
+	err = c.client.Do(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	schedules := make([]*Schedule, len(resp.Schedules))
+	for i, schedule := range resp.Schedules {
+		schedules[i] = ScheduleFromSchema(&schedule)
+	}
+	return schedules, nil
+}
+
 type ScheduleCreateOpts struct {
 	ScheduleURLOpts
 	ScheduleBase
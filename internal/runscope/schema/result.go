@@ -0,0 +1,69 @@
+package schema
+
+type Result struct {
+	TestRunId         string          `json:"test_run_id"`
+	TestRunURL        string          `json:"test_run_url"`
+	TestId            string          `json:"test_id"`
+	BucketKey         string          `json:"bucket_key"`
+	EnvironmentId     string          `json:"environment_id"`
+	EnvironmentName   string          `json:"environment_name"`
+	Region            string          `json:"region"`
+	Result            string          `json:"result"`
+	StartedAt         float64         `json:"started_at"`
+	FinishedAt        float64         `json:"finished_at"`
+	AssertionsDefined int             `json:"assertions_defined"`
+	AssertionsPassed  int             `json:"assertions_passed"`
+	AssertionsFailed  int             `json:"assertions_failed"`
+	RequestsExecuted  int             `json:"requests_executed"`
+	Requests          []ResultRequest `json:"requests"`
+}
+
+// ResultRequest is the outcome of a single step of a test run.
+type ResultRequest struct {
+	UUID               string            `json:"uuid"`
+	StepType           string            `json:"step_type"`
+	Method             string            `json:"method"`
+	URL                string            `json:"url"`
+	Result             string            `json:"result"`
+	ResponseStatusCode interface{}       `json:"response_status_code"`
+	ResponseTimeMs     int               `json:"response_time_ms"`
+	ResponseSizeBytes  int               `json:"response_size_bytes"`
+	Assertions         []ResultAssertion `json:"assertions"`
+	Variables          []ResultVariable  `json:"variables"`
+	Scripts            []ResultScript    `json:"scripts"`
+}
+
+// ResultAssertion is the outcome of an assertion. Values are numbers or
+// strings depending on the assertion source.
+type ResultAssertion struct {
+	Source      string      `json:"source"`
+	Property    string      `json:"property"`
+	Comparison  string      `json:"comparison"`
+	TargetValue interface{} `json:"target_value"`
+	ActualValue interface{} `json:"actual_value"`
+	Result      string      `json:"result"`
+	Error       string      `json:"error"`
+}
+
+type ResultVariable struct {
+	Name     string      `json:"name"`
+	Source   string      `json:"source"`
+	Property string      `json:"property"`
+	Value    interface{} `json:"value"`
+	Result   string      `json:"result"`
+	Error    string      `json:"error"`
+}
+
+type ResultScript struct {
+	Output string `json:"output"`
+	Result string `json:"result"`
+	Error  string `json:"error"`
+}
+
+type ResultGetResponse struct {
+	Result `json:"data"`
+}
+
+type ResultListResponse struct {
+	Results []Result `json:"data"`
+}
@@ -0,0 +1,209 @@
+package schema
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestUnmarshallResultGetResponse(t *testing.T) {
+	var resp ResultGetResponse
+	err := json.Unmarshal([]byte(runscopeResultGetOkResponse), &resp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.Result.Result != "fail" {
+		t.Errorf("expected result fail, got %s", resp.Result.Result)
+	}
+	if len(resp.Requests) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(resp.Requests))
+	}
+
+	request := resp.Requests[0]
+	if len(request.Assertions) != 2 || request.Assertions[1].Result != "fail" {
+		t.Errorf("unexpected assertions %+v", request.Assertions)
+	}
+	if request.Assertions[0].ActualValue != float64(200) {
+		t.Errorf("expected numeric actual value, got %#v", request.Assertions[0].ActualValue)
+	}
+	if len(request.Variables) != 1 || request.Variables[0].Name != "user_id" {
+		t.Errorf("unexpected variables %+v", request.Variables)
+	}
+	if len(request.Scripts) != 1 || request.Scripts[0].Output != "Checked user" {
+		t.Errorf("unexpected scripts %+v", request.Scripts)
+	}
+}
+
+func TestUnmarshallResultListResponse(t *testing.T) {
+	var resp ResultListResponse
+	err := json.Unmarshal([]byte(runscopeResultListOkResponse), &resp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(resp.Results) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(resp.Results))
+	}
+	if resp.Results[0].Result != "working" || resp.Results[0].FinishedAt != 0 {
+		t.Errorf("unexpected unfinished result %+v", resp.Results[0])
+	}
+}
+
+const runscopeResultGetOkResponse = `{
+  "meta": {
+    "status": "success"
+  },
+  "data": {
+    "agent": null,
+    "assertions_defined": 3,
+    "assertions_failed": 1,
+    "assertions_passed": 2,
+    "bucket_key": "ymdbe56klm54",
+    "environment_id": "1f34a6b6-7e5e-4ff9-bb34-7a1d7dd2e0b8",
+    "environment_name": "Test Settings",
+    "finished_at": 1652101243.948,
+    "region": "us1",
+    "requests": [
+      {
+        "assertions": [
+          {
+            "actual_value": 200,
+            "comparison": "equal_number",
+            "error": null,
+            "property": null,
+            "result": "pass",
+            "source": "response_status",
+            "target_value": "200"
+          },
+          {
+            "actual_value": "guest",
+            "comparison": "equal",
+            "error": null,
+            "property": "role",
+            "result": "fail",
+            "source": "response_json",
+            "target_value": "admin"
+          }
+        ],
+        "assertions_defined": 2,
+        "assertions_failed": 1,
+        "assertions_passed": 1,
+        "method": "GET",
+        "response_size_bytes": 532,
+        "response_status_code": "200",
+        "response_time_ms": 121,
+        "result": "fail",
+        "scripts": [
+          {
+            "error": null,
+            "output": "Checked user",
+            "result": "pass"
+          }
+        ],
+        "scripts_defined": 1,
+        "scripts_failed": 0,
+        "scripts_passed": 1,
+        "step_type": "request",
+        "url": "https://example.com/users/42",
+        "uuid": "d7363d46-2c07-42db-bd2e-54b37e0094cc",
+        "variables": [
+          {
+            "error": null,
+            "name": "user_id",
+            "property": "id",
+            "result": "pass",
+            "source": "response_json",
+            "value": "42"
+          }
+        ],
+        "variables_defined": 1,
+        "variables_failed": 0,
+        "variables_passed": 1
+      },
+      {
+        "assertions": [
+          {
+            "actual_value": 204,
+            "comparison": "equal_number",
+            "error": null,
+            "property": null,
+            "result": "pass",
+            "source": "response_status",
+            "target_value": "204"
+          }
+        ],
+        "assertions_defined": 1,
+        "assertions_failed": 0,
+        "assertions_passed": 1,
+        "method": "DELETE",
+        "response_size_bytes": 0,
+        "response_status_code": "204",
+        "response_time_ms": 87,
+        "result": "pass",
+        "scripts": [],
+        "step_type": "request",
+        "url": "https://example.com/users/42",
+        "uuid": "4ad7f1a3-0b4c-4c3e-b1cd-f4a1d8b6c0a2",
+        "variables": []
+      }
+    ],
+    "requests_executed": 2,
+    "result": "fail",
+    "scripts_defined": 1,
+    "scripts_failed": 0,
+    "scripts_passed": 1,
+    "started_at": 1652101243.511,
+    "test_id": "f39e5c71-05d0-4fc5-8c4b-7e6f8d6c1a52",
+    "test_run_id": "0aa48464-f89e-4596-8d60-79bc678d36f4",
+    "test_run_url": "https://www.runscope.com/radar/ymdbe56klm54/f39e5c71-05d0-4fc5-8c4b-7e6f8d6c1a52/results/0aa48464-f89e-4596-8d60-79bc678d36f4",
+    "variables_defined": 1,
+    "variables_failed": 0,
+    "variables_passed": 1
+  },
+  "error": null
+}
+`
+
+const runscopeResultListOkResponse = `{
+  "meta": {
+    "status": "success"
+  },
+  "data": [
+    {
+      "agent": null,
+      "assertions_defined": 0,
+      "assertions_failed": 0,
+      "assertions_passed": 0,
+      "bucket_key": "ymdbe56klm54",
+      "environment_id": "1f34a6b6-7e5e-4ff9-bb34-7a1d7dd2e0b8",
+      "environment_name": "Test Settings",
+      "finished_at": null,
+      "region": "us1",
+      "requests_executed": 0,
+      "result": "working",
+      "started_at": 1652101303.114,
+      "test_id": "f39e5c71-05d0-4fc5-8c4b-7e6f8d6c1a52",
+      "test_run_id": "c1b0a0f4-9c39-4a6e-9b5e-7f1d2b6b8a11",
+      "test_run_url": "https://www.runscope.com/radar/ymdbe56klm54/f39e5c71-05d0-4fc5-8c4b-7e6f8d6c1a52/results/c1b0a0f4-9c39-4a6e-9b5e-7f1d2b6b8a11"
+    },
+    {
+      "agent": null,
+      "assertions_defined": 3,
+      "assertions_failed": 1,
+      "assertions_passed": 2,
+      "bucket_key": "ymdbe56klm54",
+      "environment_id": "1f34a6b6-7e5e-4ff9-bb34-7a1d7dd2e0b8",
+      "environment_name": "Test Settings",
+      "finished_at": 1652101243.948,
+      "region": "us1",
+      "requests_executed": 2,
+      "result": "fail",
+      "started_at": 1652101243.511,
+      "test_id": "f39e5c71-05d0-4fc5-8c4b-7e6f8d6c1a52",
+      "test_run_id": "0aa48464-f89e-4596-8d60-79bc678d36f4",
+      "test_run_url": "https://www.runscope.com/radar/ymdbe56klm54/f39e5c71-05d0-4fc5-8c4b-7e6f8d6c1a52/results/0aa48464-f89e-4596-8d60-79bc678d36f4"
+    }
+  ],
+  "error": null
+}
+`
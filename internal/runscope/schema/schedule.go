@@ -15,6 +15,10 @@ type ScheduleGetResponse struct {
 	Schedule `json:"data"`
 }
 
+type ScheduleListResponse struct {
+	Schedules []Schedule `json:"data"`
+}
+
 type ScheduleCreateRequest struct {
 	ScheduleBase
 }
@@ -1,5 +1,7 @@
 package schema
 
+import "encoding/json"
+
 type StepRequest struct {
 	ID            string              `json:"id"`
 	StepType      string              `json:"step_type"`
@@ -84,3 +86,85 @@ type StepUpdateSubtestRequest struct {
 type StepUpdateSubtestResponse struct {
 	Step StepSubtest `json:"data"`
 }
+
+// StepReorderRequest holds every step of a test in the new order. Steps are
+// kept as raw JSON so that they are sent back exactly as read.
+type StepReorderRequest []json.RawMessage
+
+type StepReorderResponse struct {
+	Steps []TestStep `json:"data"`
+}
+
+type StepPause struct {
+	ID       string `json:"id"`
+	Duration int    `json:"duration"`
+}
+
+type StepCreatePauseRequest struct {
+	StepPause
+	StepType string `json:"step_type"`
+}
+type StepCreatePauseResponse struct {
+	Step []StepPause `json:"data"`
+}
+type StepGetPauseResponse struct {
+	Step StepPause `json:"data"`
+}
+type StepUpdatePauseRequest struct {
+	StepPause
+	StepType string `json:"step_type"`
+}
+type StepUpdatePauseResponse struct {
+	Step StepPause `json:"data"`
+}
+
+type StepCondition struct {
+	ID         string `json:"id"`
+	LeftValue  string `json:"left_value"`
+	Comparison string `json:"comparison"`
+	RightValue string `json:"right_value"`
+}
+
+type StepCreateConditionRequest struct {
+	StepCondition
+	StepType string `json:"step_type"`
+}
+type StepCreateConditionResponse struct {
+	Step []StepCondition `json:"data"`
+}
+type StepGetConditionResponse struct {
+	Step StepCondition `json:"data"`
+}
+type StepUpdateConditionRequest struct {
+	StepCondition
+	StepType string `json:"step_type"`
+}
+type StepUpdateConditionResponse struct {
+	Step StepCondition `json:"data"`
+}
+
+type StepIncomingRequest struct {
+	ID         string          `json:"id"`
+	Note       string          `json:"note"`
+	CaptureURL string          `json:"capture_url,omitempty"`
+	Variables  []StepVariable  `json:"variables"`
+	Assertions []StepAssertion `json:"assertions"`
+}
+
+type StepCreateIncomingRequestRequest struct {
+	StepIncomingRequest
+	StepType string `json:"step_type"`
+}
+type StepCreateIncomingRequestResponse struct {
+	Step []StepIncomingRequest `json:"data"`
+}
+type StepGetIncomingRequestResponse struct {
+	Step StepIncomingRequest `json:"data"`
+}
+type StepUpdateIncomingRequestRequest struct {
+	StepIncomingRequest
+	StepType string `json:"step_type"`
+}
+type StepUpdateIncomingRequestResponse struct {
+	Step StepIncomingRequest `json:"data"`
+}
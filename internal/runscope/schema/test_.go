@@ -20,7 +20,8 @@ type Test struct {
 }
 
 type TestStep struct {
-	Id string `json:"id"`
+	Id       string `json:"id"`
+	StepType string `json:"step_type"`
 }
 
 type CreatedBy struct {
@@ -33,6 +34,10 @@ type TestGetResponse struct {
 	Test `json:"data"`
 }
 
+type TestListResponse struct {
+	Tests []Test `json:"data"`
+}
+
 type TestCreateRequest struct {
 	TestMinimal
 }
@@ -45,6 +45,11 @@ func TestUnmarshallTestCreateResponse(t *testing.T) {
 	if resp.CreatedBy != expectedCreatedBy {
 		t.Errorf("expected CreatedBy '%+v', got '%+v'", expectedCreatedBy, resp.CreatedBy)
 	}
+
+	expectedStepType := "request"
+	if len(resp.Steps) != 1 || resp.Steps[0].StepType != expectedStepType {
+		t.Errorf("expected a single step of type '%s', got '%+v'", expectedStepType, resp.Steps)
+	}
 }
 
 const runscopeTestCreateOkResponse = `{
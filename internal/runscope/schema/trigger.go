@@ -0,0 +1,24 @@
+package schema
+
+type TestRun struct {
+	TestRunId       string            `json:"test_run_id"`
+	TestRunURL      string            `json:"test_run_url"`
+	TestId          string            `json:"test_id"`
+	BucketKey       string            `json:"bucket_key"`
+	EnvironmentId   string            `json:"environment_id"`
+	EnvironmentName string            `json:"environment_name"`
+	Region          string            `json:"region"`
+	Status          string            `json:"status"`
+	Variables       map[string]string `json:"variables"`
+}
+
+type TestTrigger struct {
+	Runs        []TestRun `json:"runs"`
+	RunsStarted int       `json:"runs_started"`
+	RunsFailed  int       `json:"runs_failed"`
+	RunsTotal   int       `json:"runs_total"`
+}
+
+type TestTriggerResponse struct {
+	TestTrigger `json:"data"`
+}
@@ -2,8 +2,11 @@ package runscope
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope/schema"
 )
 
 type StepVariable struct {
@@ -59,3 +62,72 @@ func (c *StepClient) Delete(ctx context.Context, opts *StepDeleteOpts) error {
 
 	return nil
 }
+
+type StepReorderOpts struct {
+	StepUriOpts
+	// StepIds is the new order of the steps. Steps of the test left out
+	// keep their relative order after the listed ones.
+	StepIds []string
+}
+
+// Reorder moves the steps of a test into the order of opts.StepIds and
+// returns the IDs of all steps in their new order.
+func (c *StepClient) Reorder(ctx context.Context, opts *StepReorderOpts) ([]string, error) {
+	req, err := c.client.NewRequest(ctx, http.MethodGet, opts.URL(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var current struct {
+		Steps []json.RawMessage `json:"data"`
+	}
+	if err := c.client.Do(req, &current); err != nil {
+		return nil, err
+	}
+
+	steps := map[string]json.RawMessage{}
+	var ids []string
+	for _, raw := range current.Steps {
+		var step schema.TestStep
+		if err := json.Unmarshal(raw, &step); err != nil {
+			return nil, err
+		}
+		steps[step.Id] = raw
+		ids = append(ids, step.Id)
+	}
+
+	body := schema.StepReorderRequest{}
+	listed := map[string]bool{}
+	for _, id := range opts.StepIds {
+		raw, ok := steps[id]
+		if !ok {
+			return nil, fmt.Errorf("step %s not found in test %s", id, opts.TestId)
+		}
+		if listed[id] {
+			return nil, fmt.Errorf("step %s is listed more than once", id)
+		}
+		listed[id] = true
+		body = append(body, raw)
+	}
+	for _, id := range ids {
+		if !listed[id] {
+			body = append(body, steps[id])
+		}
+	}
+
+	req, err = c.client.NewRequest(ctx, http.MethodPut, opts.URL(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp schema.StepReorderResponse
+	if err := c.client.Do(req, &resp); err != nil {
+		return nil, err
+	}
+
+	ids = make([]string, len(resp.Steps))
+	for i, step := range resp.Steps {
+		ids[i] = step.Id
+	}
+	return ids, nil
+}
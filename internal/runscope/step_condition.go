@@ -0,0 +1,102 @@
+package runscope
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope/schema"
+)
+
+func StepConditionFromSchema(s *schema.StepCondition) *StepCondition {
+	return &StepCondition{
+		ID:         s.ID,
+		LeftValue:  s.LeftValue,
+		Comparison: s.Comparison,
+		RightValue: s.RightValue,
+	}
+}
+
+// StepCondition is a step comparing two values. When the comparison fails
+// the remaining steps of the test are skipped.
+type StepCondition struct {
+	ID         string
+	LeftValue  string
+	Comparison string
+	RightValue string
+}
+
+type StepConditionOpts struct {
+	LeftValue  string
+	Comparison string
+	RightValue string
+}
+
+func (sco *StepConditionOpts) setRequest(sc *schema.StepCondition) {
+	sc.LeftValue = sco.LeftValue
+	sc.Comparison = sco.Comparison
+	sc.RightValue = sco.RightValue
+}
+
+type StepCreateConditionOpts struct {
+	StepUriOpts
+	StepConditionOpts
+}
+
+func (c *StepClient) CreateCondition(ctx context.Context, opts *StepCreateConditionOpts) (*StepCondition, error) {
+	body := schema.StepCreateConditionRequest{
+		StepType: "condition",
+	}
+	opts.StepConditionOpts.setRequest(&body.StepCondition)
+	req, err := c.client.NewRequest(ctx, http.MethodPost, opts.StepUriOpts.URL(), &body)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp schema.StepCreateConditionResponse
+	if err := c.client.Do(req, &resp); err != nil {
+		return nil, err
+	}
+
+	if len(resp.Step) < 1 {
+		return nil, fmt.Errorf("no steps returned after created")
+	}
+
+	return StepConditionFromSchema(&resp.Step[len(resp.Step)-1]), nil
+}
+
+func (c *StepClient) GetCondition(ctx context.Context, opts *StepGetRequestOpts) (*StepCondition, error) {
+	var resp schema.StepGetConditionResponse
+	req, err := c.client.NewRequest(ctx, http.MethodGet, opts.URL(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create req: %w", err)
+	}
+
+	err = c.client.Do(req, &resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call endpoint: %w", err)
+	}
+
+	return StepConditionFromSchema(&resp.Step), nil
+}
+
+type StepUpdateConditionOpts struct {
+	StepGetRequestOpts
+	StepConditionOpts
+}
+
+func (c *StepClient) UpdateCondition(ctx context.Context, opts *StepUpdateConditionOpts) (*StepCondition, error) {
+	body := &schema.StepUpdateConditionRequest{
+		StepType: "condition",
+	}
+	opts.setRequest(&body.StepCondition)
+	body.ID = opts.Id
+
+	var resp schema.StepUpdateConditionResponse
+	err := c.client.update(ctx, opts.URL(), &body, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return StepConditionFromSchema(&resp.Step), nil
+}
@@ -0,0 +1,137 @@
+package runscope
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope/schema"
+)
+
+func StepIncomingRequestFromSchema(s *schema.StepIncomingRequest) *StepIncomingRequest {
+	step := &StepIncomingRequest{
+		ID:         s.ID,
+		Note:       s.Note,
+		CaptureURL: s.CaptureURL,
+		Variables:  make([]StepVariable, len(s.Variables)),
+		Assertions: make([]StepAssertion, len(s.Assertions)),
+	}
+	for i, v := range s.Variables {
+		step.Variables[i] = StepVariable{
+			Name:     v.Name,
+			Property: v.Property,
+			Source:   v.Source,
+		}
+	}
+	for i, a := range s.Assertions {
+		step.Assertions[i] = StepAssertion{
+			Source:     a.Source,
+			Property:   a.Property,
+			Comparison: a.Comparison,
+			Value:      a.Value,
+		}
+	}
+
+	return step
+}
+
+// StepIncomingRequest is a step that waits for a request to be sent to
+// its generated capture URL, e.g. by a webhook of the system under test.
+type StepIncomingRequest struct {
+	ID         string
+	Note       string
+	CaptureURL string
+	Variables  []StepVariable
+	Assertions []StepAssertion
+}
+
+type StepIncomingRequestOpts struct {
+	Note       string
+	Variables  []StepVariable
+	Assertions []StepAssertion
+}
+
+func (sio *StepIncomingRequestOpts) setRequest(si *schema.StepIncomingRequest) {
+	si.Note = sio.Note
+
+	si.Variables = make([]schema.StepVariable, len(sio.Variables))
+	si.Assertions = make([]schema.StepAssertion, len(sio.Assertions))
+	for i, v := range sio.Variables {
+		si.Variables[i] = schema.StepVariable{
+			Name:     v.Name,
+			Property: v.Property,
+			Source:   v.Source,
+		}
+	}
+	for i, a := range sio.Assertions {
+		si.Assertions[i] = schema.StepAssertion{
+			Source:     a.Source,
+			Property:   a.Property,
+			Comparison: a.Comparison,
+			Value:      a.Value,
+		}
+	}
+}
+
+type StepCreateIncomingRequestOpts struct {
+	StepUriOpts
+	StepIncomingRequestOpts
+}
+
+func (c *StepClient) CreateIncomingRequest(ctx context.Context, opts *StepCreateIncomingRequestOpts) (*StepIncomingRequest, error) {
+	body := schema.StepCreateIncomingRequestRequest{
+		StepType: "incoming_request",
+	}
+	opts.StepIncomingRequestOpts.setRequest(&body.StepIncomingRequest)
+	req, err := c.client.NewRequest(ctx, http.MethodPost, opts.StepUriOpts.URL(), &body)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp schema.StepCreateIncomingRequestResponse
+	if err := c.client.Do(req, &resp); err != nil {
+		return nil, err
+	}
+
+	if len(resp.Step) < 1 {
+		return nil, fmt.Errorf("no steps returned after created")
+	}
+
+	return StepIncomingRequestFromSchema(&resp.Step[len(resp.Step)-1]), nil
+}
+
+func (c *StepClient) GetIncomingRequest(ctx context.Context, opts *StepGetRequestOpts) (*StepIncomingRequest, error) {
+	var resp schema.StepGetIncomingRequestResponse
+	req, err := c.client.NewRequest(ctx, http.MethodGet, opts.URL(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create req: %w", err)
+	}
+
+	err = c.client.Do(req, &resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call endpoint: %w", err)
+	}
+
+	return StepIncomingRequestFromSchema(&resp.Step), nil
+}
+
+type StepUpdateIncomingRequestOpts struct {
+	StepGetRequestOpts
+	StepIncomingRequestOpts
+}
+
+func (c *StepClient) UpdateIncomingRequest(ctx context.Context, opts *StepUpdateIncomingRequestOpts) (*StepIncomingRequest, error) {
+	body := &schema.StepUpdateIncomingRequestRequest{
+		StepType: "incoming_request",
+	}
+	opts.setRequest(&body.StepIncomingRequest)
+	body.ID = opts.Id
+
+	var resp schema.StepUpdateIncomingRequestResponse
+	err := c.client.update(ctx, opts.URL(), &body, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return StepIncomingRequestFromSchema(&resp.Step), nil
+}
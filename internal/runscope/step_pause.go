@@ -0,0 +1,92 @@
+package runscope
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope/schema"
+)
+
+func StepPauseFromSchema(s *schema.StepPause) *StepPause {
+	return &StepPause{
+		ID:       s.ID,
+		Duration: s.Duration,
+	}
+}
+
+type StepPause struct {
+	ID       string
+	Duration int
+}
+
+type StepPauseOpts struct {
+	Duration int
+}
+
+func (spo *StepPauseOpts) setRequest(sp *schema.StepPause) {
+	sp.Duration = spo.Duration
+}
+
+type StepCreatePauseOpts struct {
+	StepUriOpts
+	StepPauseOpts
+}
+
+func (c *StepClient) CreatePause(ctx context.Context, opts *StepCreatePauseOpts) (*StepPause, error) {
+	body := schema.StepCreatePauseRequest{
+		StepType: "pause",
+	}
+	opts.StepPauseOpts.setRequest(&body.StepPause)
+	req, err := c.client.NewRequest(ctx, http.MethodPost, opts.StepUriOpts.URL(), &body)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp schema.StepCreatePauseResponse
+	if err := c.client.Do(req, &resp); err != nil {
+		return nil, err
+	}
+
+	if len(resp.Step) < 1 {
+		return nil, fmt.Errorf("no steps returned after created")
+	}
+
+	return StepPauseFromSchema(&resp.Step[len(resp.Step)-1]), nil
+}
+
+func (c *StepClient) GetPause(ctx context.Context, opts *StepGetRequestOpts) (*StepPause, error) {
+	var resp schema.StepGetPauseResponse
+	req, err := c.client.NewRequest(ctx, http.MethodGet, opts.URL(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create req: %w", err)
+	}
+
+	err = c.client.Do(req, &resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call endpoint: %w", err)
+	}
+
+	return StepPauseFromSchema(&resp.Step), nil
+}
+
+type StepUpdatePauseOpts struct {
+	StepGetRequestOpts
+	StepPauseOpts
+}
+
+func (c *StepClient) UpdatePause(ctx context.Context, opts *StepUpdatePauseOpts) (*StepPause, error) {
+	body := &schema.StepUpdatePauseRequest{
+		StepType: "pause",
+	}
+	opts.setRequest(&body.StepPause)
+	body.ID = opts.Id
+
+	var resp schema.StepUpdatePauseResponse
+	err := c.client.update(ctx, opts.URL(), &body, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return StepPauseFromSchema(&resp.Step), nil
+}
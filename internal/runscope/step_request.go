@@ -164,6 +164,10 @@ func (c *StepClient) CreateRequest(ctx context.Context, opts *StepCreateRequestO
 		return nil, err
 	}
 
+	if len(resp.Step) < 1 {
+		return nil, fmt.Errorf("no steps returned after created")
+	}
+
 	return StepRequestFromSchema(&resp.Step[len(resp.Step)-1]), nil
 }
 
@@ -207,13 +211,8 @@ func (c *StepClient) UpdateRequest(ctx context.Context, opts *StepUpdateRequestO
 		"url":  opts.URL(),
 		"body": body,
 	})
-	req, err := c.client.NewRequest(ctx, http.MethodPut, opts.URL(), &body)
-	if err != nil {
-		return nil, err
-	}
-
 	var resp schema.StepUpdateRequstResponse
-	err = c.client.Do(req, &resp)
+	err := c.client.update(ctx, opts.URL(), &body, &resp)
 	if err != nil {
 		return nil, err
 	}
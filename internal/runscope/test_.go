@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope/schema"
+	"net/url"
+	"strconv"
 	"time"
 )
 
@@ -29,7 +31,8 @@ type Test struct {
 }
 
 type TestStep struct {
-	Id string
+	Id       string
+	StepType string
 }
 
 type CreatedBy struct {
@@ -51,6 +54,7 @@ func TestFromSchema(s schema.Test) *Test {
 	test.Steps = make([]TestStep, len(s.Steps))
 	for i, step := range s.Steps {
 		test.Steps[i].Id = step.Id
+		test.Steps[i].StepType = step.StepType
 	}
 	test.CreatedAt = time.Unix(s.CreatedAt, 0)
 	test.CreatedBy = CreatedBy{
@@ -84,6 +88,45 @@ func (c *TestClient) Get(ctx context.Context, opts TestGetOpts) (*Test, error) {
 	return TestFromSchema(resp.Test), err
 }
 
+// testListPageSize is the number of tests requested per page, the most the
+// API allows.
+const testListPageSize = 50
+
+type TestListOpts struct {
+	BucketId string
+}
+
+// List returns every test of a bucket, following pagination.
+func (c *TestClient) List(ctx context.Context, opts TestListOpts) ([]*Test, error) {
+	var tests []*Test
+	for offset := 0; ; offset += testListPageSize {
+		params := url.Values{}
+		params.Set("count", strconv.Itoa(testListPageSize))
+		params.Set("offset", strconv.Itoa(offset))
+
+		req, err := c.client.NewRequest(ctx,
+			"GET", fmt.Sprintf("/buckets/%s/tests?%s", opts.BucketId, params.Encode()),
+			nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var resp schema.TestListResponse
+		err = c.client.Do(req, &resp)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, test := range resp.Tests {
+			tests = append(tests, TestFromSchema(test))
+		}
+
+		if len(resp.Tests) < testListPageSize {
+			return tests, nil
+		}
+	}
+}
+
 type TestCreateOpts struct {
 	TestMinimal
 	BucketId string
@@ -109,6 +152,10 @@ func (c *TestClient) Create(ctx context.Context, opts TestCreateOpts) (*Test, er
 	return TestFromSchema(resp.Test), err
 }
 
+// testManagedFields are fields read along with a test that are changed
+// through endpoints of their own and so aren't sent back when updating it.
+var testManagedFields = []string{"steps", "environments", "schedules", "last_run"}
+
 type TestUpdateOpts struct {
 	Test
 	BucketId string
@@ -124,15 +171,9 @@ func (c *TestClient) Update(ctx context.Context, opts TestUpdateOpts) (*Test, er
 	body := schema.TestUpdateRequest{}
 	opts.setRequest(&body)
 
-	req, err := c.client.NewRequest(ctx,
-		"PUT", fmt.Sprintf("/buckets/%s/tests/%s", opts.BucketId, opts.Id),
-		&body)
-	if err != nil {
-		return nil, err
-	}
-
 	var resp schema.TestUpdateResponse
-	err = c.client.Do(req, &resp)
+	err := c.client.update(ctx, fmt.Sprintf("/buckets/%s/tests/%s", opts.BucketId, opts.Id),
+		&body, &resp, testManagedFields...)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,88 @@
+package runscope
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/terraform-providers/terraform-provider-runscope/internal/runscope/schema"
+)
+
+// TestRun is a run started by triggering a test.
+type TestRun struct {
+	Id              string
+	URL             string
+	TestId          string
+	BucketId        string
+	EnvironmentId   string
+	EnvironmentName string
+	Region          string
+	Status          string
+	Variables       map[string]string
+}
+
+func TestRunFromSchema(s *schema.TestRun) *TestRun {
+	return &TestRun{
+		Id:              s.TestRunId,
+		URL:             s.TestRunURL,
+		TestId:          s.TestId,
+		BucketId:        s.BucketKey,
+		EnvironmentId:   s.EnvironmentId,
+		EnvironmentName: s.EnvironmentName,
+		Region:          s.Region,
+		Status:          s.Status,
+		Variables:       s.Variables,
+	}
+}
+
+type TestTriggerOpts struct {
+	TriggerURL    string
+	EnvironmentId string
+	Variables     map[string]string
+}
+
+// URL returns the trigger URL with the environment and initial variables
+// passed as query parameters.
+func (opts *TestTriggerOpts) URL() (string, error) {
+	u, err := url.Parse(opts.TriggerURL)
+	if err != nil {
+		return "", err
+	}
+
+	q := u.Query()
+	for k, v := range opts.Variables {
+		q.Set(k, v)
+	}
+	if opts.EnvironmentId != "" {
+		q.Set("runscope_environment", opts.EnvironmentId)
+	}
+	u.RawQuery = q.Encode()
+
+	return u.String(), nil
+}
+
+// Trigger starts a run of the test behind opts.TriggerURL. The API starts
+// one run for every region of the environment.
+func (c *TestClient) Trigger(ctx context.Context, opts TestTriggerOpts) ([]TestRun, error) {
+	triggerURL, err := opts.URL()
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.client.NewRequest(ctx, http.MethodPost, triggerURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp schema.TestTriggerResponse
+	err = c.client.Do(req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	runs := make([]TestRun, len(resp.Runs))
+	for i, run := range resp.Runs {
+		runs[i] = *TestRunFromSchema(&run)
+	}
+	return runs, nil
+}